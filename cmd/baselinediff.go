@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/zricethezav/gitleaks/v8/detect"
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+func init() {
+	rootCmd.AddCommand(baselineDiffCmd)
+	baselineDiffCmd.Flags().Bool("show-removed", false, "also log the baseline findings that are no longer found")
+}
+
+var baselineDiffCmd = &cobra.Command{
+	Use:   "baseline-diff <baseline-report> <current-report>",
+	Short: "print findings of a json report that are not in a baseline report, one json object per line",
+	Args:  cobra.ExactArgs(2),
+	Run:   runBaselineDiff,
+}
+
+func runBaselineDiff(cmd *cobra.Command, args []string) {
+	baseline, err := detect.LoadBaseline(args[0])
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load baseline report")
+	}
+	current, err := detect.LoadBaseline(args[1])
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load current report")
+	}
+
+	added, removed := report.Diff(current, baseline)
+	if err := report.WriteJSONLines(os.Stdout, added); err != nil {
+		log.Fatal().Err(err).Msg("could not write findings")
+	}
+
+	if showRemoved, _ := cmd.Flags().GetBool("show-removed"); showRemoved {
+		for _, f := range removed {
+			log.Info().Msgf("resolved: %s in %s:%d", f.RuleID, f.File, f.StartLine)
+		}
+	}
+	log.Info().Msgf("%d new findings, %d resolved findings", len(added), len(removed))
+
+	if len(added) != 0 {
+		exitCode, _ := cmd.Flags().GetInt("exit-code")
+		os.Exit(exitCode)
+	}
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -15,6 +18,18 @@ func init() {
 	rootCmd.AddCommand(detectCmd)
 	detectCmd.Flags().Bool("no-git", false, "treat git repo as a regular directory and scan those files, --log-opts has no effect on the scan when --no-git is set")
 	detectCmd.Flags().Bool("pipe", false, "scan input from stdin, ex: `cat some_file | gitleaks detect --pipe`")
+	detectCmd.Flags().String("archive", "", "scan the files of a tar or tar.gz archive instead of a git repository, use - to read it from stdin, ex: `git archive v1.0.0 | gitleaks detect --archive -`")
+	detectCmd.Flags().StringSlice("files", nil, "only scan these files, without git, ex: `gitleaks detect --files=main.go,config.yaml`")
+	detectCmd.Flags().Bool("submodules", false, "also scan the history of initialized git submodules, run `git submodule update --init --recursive` first")
+	detectCmd.Flags().Bool("latest-commit", false, "only scan the lines added by the commit at HEAD, merge commits are diffed against their first parent, ex: in a post-receive hook")
+	detectCmd.Flags().StringSlice("commits", nil, "only scan these commits, each diffed against its parent, ex: `--commits=491504d,1b6da43`")
+	detectCmd.Flags().Int("max-commits", 0, "only scan the N most recent commits, 0 scans the full history")
+	detectCmd.Flags().Bool("relative-paths", false, "report file paths relative to --source when --no-git is set, like the paths of git scans")
+	detectCmd.Flags().String("base-sha", "", "only scan lines added between the merge base of this commit and --head-sha, ex: `--base-sha=$BASE_SHA`")
+	detectCmd.Flags().String("commit-message-include", "", "only scan commits with a message line matching this Perl-compatible regex, like git log --grep")
+	detectCmd.Flags().String("commit-message-exclude", "", "skip commits with a message line matching this Perl-compatible regex, ex: `--commit-message-exclude='^Merge branch'`")
+	detectCmd.Flags().String("head-sha", "HEAD", "commit to compare against --base-sha")
+	detectCmd.Flags().String("pipe-path", "/dev/stdin", "file path reported for findings when scanning with --pipe, path rules and allowlists are matched against it")
 }
 
 var detectCmd = &cobra.Command{
@@ -61,11 +76,60 @@ func runDetect(cmd *cobra.Command, args []string) {
 		log.Fatal().Err(err)
 	}
 
+	archivePath, err := cmd.Flags().GetString("archive")
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	files, err := cmd.Flags().GetStringSlice("files")
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+
 	// start the detector scan
-	if noGit {
-		paths, err := sources.DirectoryTargets(source, detector.Sema, detector.FollowSymlinks)
+	if archivePath != "" {
+		archive := os.Stdin
+		if archivePath != "-" {
+			if archive, err = os.Open(archivePath); err != nil {
+				log.Fatal().Err(err).Msg("could not open archive")
+			}
+			defer archive.Close()
+		}
+		findings, err = detector.DetectArchive(archive)
 		if err != nil {
-			log.Fatal().Err(err)
+			// don't exit on error, just log it
+			log.Error().Err(err).Msg("")
+		}
+	} else if len(files) > 0 {
+		// assign to the outer err, it is passed to findingSummaryAndExit
+		var paths <-chan sources.ScanTarget
+		paths, err = sources.FileTargets(files, detector.Sema)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		findings, err = detector.DetectFiles(paths)
+		if err != nil {
+			// don't exit on error, just log it
+			log.Error().Err(err).Msg("")
+		}
+	} else if noGit {
+		if sources.IsBareRepo(source) {
+			log.Warn().Msgf("%s is a bare repository, its files are git objects, remove --no-git to scan its history", source)
+		}
+		var relativePaths bool
+		relativePaths, err = cmd.Flags().GetBool("relative-paths")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		if relativePaths {
+			detector.RelativeTo = source
+			if info, err := os.Stat(source); err == nil && !info.IsDir() {
+				detector.RelativeTo = filepath.Dir(source)
+			}
+		}
+		var paths <-chan sources.ScanTarget
+		paths, err = sources.DirectoryTargets(source, detector.Sema, detector.FollowSymlinks)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
 		}
 		findings, err = detector.DetectFiles(paths)
 		if err != nil {
@@ -73,19 +137,94 @@ func runDetect(cmd *cobra.Command, args []string) {
 			log.Error().Err(err).Msg("")
 		}
 	} else if fromPipe {
-		findings, err = detector.DetectReader(os.Stdin, 10)
+		var pipePath string
+		pipePath, err = cmd.Flags().GetString("pipe-path")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		findings, err = detector.DetectLines(os.Stdin, pipePath)
 		if err != nil {
 			// log fatal to exit, no need to continue since a report
 			// will not be generated when scanning from a pipe...for now
 			log.Fatal().Err(err).Msg("")
 		}
 	} else {
-		var logOpts string
+		var (
+			logOpts, baseSHA, headSHA string
+			messageInclude            string
+			messageExclude            string
+			maxCommits                int
+			commits                   []string
+			latestCommit              bool
+		)
 		logOpts, err = cmd.Flags().GetString("log-opts")
 		if err != nil {
 			log.Fatal().Err(err).Msg("")
 		}
-		gitCmd, err := sources.NewGitLogCmd(source, logOpts)
+		baseSHA, err = cmd.Flags().GetString("base-sha")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		headSHA, err = cmd.Flags().GetString("head-sha")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		maxCommits, err = cmd.Flags().GetInt("max-commits")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		if messageInclude, err = commitMessagePattern(cmd, "commit-message-include"); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		if messageExclude, err = commitMessagePattern(cmd, "commit-message-exclude"); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		// git filters the commits by message, except for the exclude
+		// pattern when both are set
+		messageArgs := sources.CommitMessageArgs(messageInclude, messageExclude)
+		if messageInclude != "" && messageExclude != "" {
+			detector.CommitMessageExclude = regexp.MustCompile(messageExclude)
+		}
+		commits, err = cmd.Flags().GetStringSlice("commits")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		latestCommit, err = cmd.Flags().GetBool("latest-commit")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		var gitCmd *sources.GitCmd
+		if latestCommit {
+			if logOpts != "" || baseSHA != "" || len(commits) > 0 {
+				log.Warn().Msg("--log-opts, --base-sha and --commits have no effect when --latest-commit is set")
+			}
+			gitCmd, err = sources.NewGitLatestCommitCmd(source, messageArgs...)
+		} else if len(commits) > 0 {
+			if logOpts != "" || baseSHA != "" {
+				log.Warn().Msg("--log-opts and --base-sha have no effect when --commits is set")
+			}
+			gitCmd, err = sources.NewGitCommitsCmd(source, commits, messageArgs...)
+		} else if baseSHA != "" {
+			if logOpts != "" {
+				log.Warn().Msg("--log-opts has no effect when --base-sha is set")
+			}
+			gitCmd, err = sources.NewGitDeltaCmd(source, baseSHA, headSHA)
+		} else {
+			if maxCommits > 0 {
+				logOpts = sources.LimitLogOpts(logOpts, maxCommits)
+			}
+			if submodules, _ := cmd.Flags().GetBool("submodules"); submodules {
+				logOpts = sources.SubmoduleLogOpts(logOpts)
+				uninitialized, err := sources.UninitializedSubmodules(source)
+				if err != nil {
+					log.Fatal().Err(err).Msg("could not list submodules")
+				}
+				for _, path := range uninitialized {
+					log.Warn().Msgf("submodule %s is not initialized and will not be scanned", path)
+				}
+			}
+			gitCmd, err = sources.NewGitLogCmd(source, logOpts, messageArgs...)
+		}
 		if err != nil {
 			log.Fatal().Err(err).Msg("")
 		}
@@ -96,5 +235,19 @@ func runDetect(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	findingSummaryAndExit(findings, cmd, cfg, exitCode, start, err)
+	findingSummaryAndExit(detector, findings, cmd, cfg, exitCode, start, err)
+}
+
+// commitMessagePattern returns the regex of a commit message flag. git
+// matches it as a Perl-compatible regex, checking that it compiles as a Go
+// regex reports most mistakes before git runs.
+func commitMessagePattern(cmd *cobra.Command, flag string) (string, error) {
+	pattern, err := cmd.Flags().GetString(flag)
+	if err != nil || pattern == "" {
+		return "", err
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return "", fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+	return pattern, nil
 }
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"text/template"
 
 	"github.com/rs/zerolog/log"
@@ -35,12 +37,15 @@ func main() {
 		rules.AsanaClientID(),
 		rules.AsanaClientSecret(),
 		rules.Atlassian(),
+		rules.Auth0ClientSecret(),
 		rules.Authress(),
 		rules.AWS(),
+		rules.AzureStorage(),
 		rules.BitBucketClientID(),
 		rules.BitBucketClientSecret(),
 		rules.BittrexAccessKey(),
 		rules.BittrexSecretKey(),
+		rules.BoxDeveloperToken(),
 		rules.Beamer(),
 		rules.CodecovAccessToken(),
 		rules.CoinbaseAccessToken(),
@@ -53,6 +58,8 @@ func main() {
 		rules.Contentful(),
 		rules.Databricks(),
 		rules.DatadogtokenAccessToken(),
+		rules.DatadogAPIKey(),
+		rules.DatadogAppKey(),
 		rules.DefinedNetworkingAPIToken(),
 		rules.DigitalOceanPAT(),
 		rules.DigitalOceanOAuthToken(),
@@ -60,6 +67,8 @@ func main() {
 		rules.DiscordAPIToken(),
 		rules.DiscordClientID(),
 		rules.DiscordClientSecret(),
+		rules.DockerHubPAT(),
+		rules.DockerConfigAuth(),
 		rules.Doppler(),
 		rules.DropBoxAPISecret(),
 		rules.DropBoxLongLivedAPIToken(),
@@ -102,6 +111,8 @@ func main() {
 		rules.Hashicorp(),
 		rules.HashicorpField(),
 		rules.Heroku(),
+		rules.HighEntropyBase64(),
+		rules.HighEntropyHex(),
 		rules.HubSpot(),
 		rules.HuggingFaceAccessToken(),
 		rules.HuggingFaceOrganizationApiToken(),
@@ -111,13 +122,17 @@ func main() {
 		rules.JWT(),
 		rules.JWTBase64(),
 		rules.KrakenAccessToken(),
+		rules.K8sServiceAccountToken(),
+		rules.Kubeconfig(),
 		rules.KucoinAccessToken(),
 		rules.KucoinSecretKey(),
 		rules.LaunchDarklyAccessToken(),
+		rules.LaunchDarklyAPIKey(),
 		rules.LinearAPIToken(),
 		rules.LinearClientSecret(),
 		rules.LinkedinClientID(),
 		rules.LinkedinClientSecret(),
+		rules.LinodeAPIToken(),
 		rules.LobAPIToken(),
 		rules.LobPubAPIToken(),
 		rules.MailChimp(),
@@ -128,14 +143,19 @@ func main() {
 		rules.MattermostAccessToken(),
 		rules.MessageBirdAPIToken(),
 		rules.MessageBirdClientID(),
+		rules.MixpanelToken(),
 		rules.NetlifyAccessToken(),
+		rules.NetlifyPAT(),
 		rules.NewRelicUserID(),
 		rules.NewRelicUserKey(),
 		rules.NewRelicBrowserAPIKey(),
+		rules.NewRelicLicenseKey(),
 		rules.NPM(),
 		rules.NytimesAccessToken(),
 		rules.OktaAccessToken(),
 		rules.OpenAI(),
+		rules.OpsgenieAPIKey(),
+		rules.PagerDutyAPIKey(),
 		rules.PlaidAccessID(),
 		rules.PlaidSecretKey(),
 		rules.PlaidAccessToken(),
@@ -149,13 +169,17 @@ func main() {
 		rules.PyPiUploadToken(),
 		rules.RapidAPIAccessToken(),
 		rules.ReadMe(),
+		rules.RollbarAccessToken(),
 		rules.RubyGemsAPIToken(),
 		rules.ScalingoAPIToken(),
+		rules.SegmentWriteKey(),
+		rules.SensitiveFilenames(),
 		rules.SendbirdAccessID(),
 		rules.SendbirdAccessToken(),
 		rules.SendGridAPIToken(),
 		rules.SendInBlueAPIToken(),
 		rules.SentryAccessToken(),
+		rules.SentryDSN(),
 		rules.ShippoAPIToken(),
 		rules.ShopifyAccessToken(),
 		rules.ShopifyCustomAccessToken(),
@@ -175,6 +199,7 @@ func main() {
 		rules.Snyk(),
 		rules.StripeAccessToken(),
 		rules.SquareAccessToken(),
+		rules.SquareSecret(),
 		rules.SquareSpaceAccessToken(),
 		rules.SumoLogicAccessID(),
 		rules.SumoLogicAccessToken(),
@@ -202,6 +227,9 @@ func main() {
 	// ensure rules have unique ids
 	ruleLookUp := make(map[string]config.Rule, len(configRules))
 	for _, rule := range configRules {
+		if rule.RuleID == "" {
+			log.Fatal().Msgf("rule %q is missing an id", rule.Description)
+		}
 		// check if rule is in ruleLookUp
 		if _, ok := ruleLookUp[rule.RuleID]; ok {
 			log.Fatal().Msgf("rule id %s is not unique", rule.RuleID)
@@ -211,7 +239,9 @@ func main() {
 		ruleLookUp[rule.RuleID] = *rule
 	}
 
-	tmpl, err := template.ParseFiles(templatePath)
+	tmpl, err := template.New("config.tmpl").Funcs(template.FuncMap{
+		"tomlString": tomlString,
+	}).ParseFiles(templatePath)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to parse template")
 	}
@@ -226,3 +256,30 @@ func main() {
 	}
 
 }
+
+// tomlString quotes s as a TOML basic string. Examples can contain quotes,
+// backslashes and newlines, so they cannot be written as literal strings
+// like the regexes.
+func tomlString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r < 0x20 || r == 0x7f:
+			sb.WriteString(fmt.Sprintf(`\u%04X`, r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
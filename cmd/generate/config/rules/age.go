@@ -3,6 +3,7 @@ package rules
 import (
 	"regexp"
 
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
 
@@ -13,11 +14,24 @@ func AgeSecretKey() *config.Rule {
 		RuleID:      "age-secret-key",
 		Regex:       regexp.MustCompile(`AGE-SECRET-KEY-1[QPZRY9X8GF2TVDW0S3JN54KHCE6MUA7L]{58}`),
 		Keywords:    []string{"AGE-SECRET-KEY-1"},
+		// 58 bech32 characters after the AGE-SECRET-KEY-1 prefix, the tps
+		// score 4.6-4.8, a key of Q's 1.45
+		Entropy: 3.5,
+		Tags:    []string{"encryption", "private-key"},
 	}
 
 	// validate
 	tps := []string{
-		`apiKey := "AGE-SECRET-KEY-1QQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQ`, // gitleaks:allow
+		`apiKey := "AGE-SECRET-KEY-1` + secrets.NewSecret(`[QPZRY9X8GF2TVDW0S3JN54KHCE6MUA7L]{58}`) + `"`,
+		// key file written by age-keygen, also used as SOPS_AGE_KEY_FILE
+		"# public key: age1" + secrets.NewSecret(`[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{58}`) + "\n" +
+			"AGE-SECRET-KEY-1" + secrets.NewSecret(`[QPZRY9X8GF2TVDW0S3JN54KHCE6MUA7L]{58}`),
+		"SOPS_AGE_KEY=AGE-SECRET-KEY-1" + secrets.NewSecret(`[QPZRY9X8GF2TVDW0S3JN54KHCE6MUA7L]{58}`),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// recipients are public keys and are committed in .sops.yaml
+		"age: age1" + secrets.NewSecret(`[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{58}`),
+		`apiKey := "AGE-SECRET-KEY-1QQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQ"`,
+	}
+	return validate(r, tps, fps)
 }
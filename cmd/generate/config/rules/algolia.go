@@ -10,13 +10,24 @@ func AlgoliaApiKey() *config.Rule {
 	r := config.Rule{
 		Description: "Identified an Algolia API Key, which could result in unauthorized search operations and data exposure on Algolia-managed platforms.",
 		RuleID:      "algolia-api-key",
-		Regex:       generateSemiGenericRegex([]string{"algolia"}, `[a-z0-9]{32}`, true),
+		Regex:       generateSemiGenericRegex([]string{"algolia"}, hex("32"), true),
 		Keywords:    []string{"algolia"},
+		// 32 hex characters, the tps score 3.3-3.7, the lowest of the
+		// hex rules, so only repetitive placeholders are skipped
+		Entropy: 2.5,
+		Tags:    []string{"algolia", "saas"},
 	}
 
 	// validate
 	tps := []string{
 		"algolia_key := " + secrets.NewSecret(hex("32")),
+		"X-Algolia-API-Key: " + secrets.NewSecret(hex("32")),
+		`ALGOLIA_ADMIN_API_KEY="` + secrets.NewSecret(hex("32")) + `"`,
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// md5 of a file, not near an algolia keyword
+		`checksum = "` + secrets.NewSecret(hex("32")) + `"`,
+		"ALGOLIA_ADMIN_API_KEY=" + secrets.NewSecret(`0{32}`),
+	}
+	return validate(r, tps, fps)
 }
@@ -10,15 +10,31 @@ func Atlassian() *config.Rule {
 	r := config.Rule{
 		Description: "Detected an Atlassian API token, posing a threat to project management and collaboration tool security and data confidentiality.",
 		RuleID:      "atlassian-api-token",
+		// legacy 24 character api tokens and bitbucket app passwords, and
+		// current api tokens starting with ATATT3
 		Regex: generateSemiGenericRegex([]string{
-			"atlassian", "confluence", "jira"}, alphaNumeric("24"), true),
-		Keywords: []string{"atlassian", "confluence", "jira"},
+			"atlassian", "confluence", "jira", "bitbucket"},
+			`(?:`+alphaNumeric("24")+`|ATATT3[a-z0-9_\-=]{186})`, true),
+		Keywords: []string{"atlassian", "confluence", "jira", "bitbucket"},
+		// the tps score 3.8 for 24 alphanumeric characters and 5.7 for
+		// ATATT3 tokens, ATATT3 followed by x's scores 0.25
+		Entropy: 3,
+		Tags:    []string{"atlassian"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("atlassian", secrets.NewSecret(alphaNumeric("24"))),
 		generateSampleSecret("confluence", secrets.NewSecret(alphaNumeric("24"))),
+		"BITBUCKET_APP_PASSWORD=" + secrets.NewSecret(`[A-Za-z0-9]{24}`),
+		"JIRA_API_TOKEN=ATATT3" + secrets.NewSecret(`[A-Za-z0-9_\-]{177}`) + "=" + secrets.NewSecret(`[A-F0-9]{8}`),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// not near an atlassian keyword
+		`api_token = "ATATT3` + secrets.NewSecret(`[A-Za-z0-9_\-]{177}`) + "=" + secrets.NewSecret(`[A-F0-9]{8}`) + `"`,
+		// truncated token
+		"JIRA_API_TOKEN=ATATT3" + secrets.NewSecret(`[A-Za-z0-9_\-]{100}`),
+		generateSampleSecret("jira", "xxxxxxxxxxxxxxxxxxxxxxxx"),
+	}
+	return validate(r, tps, fps)
 }
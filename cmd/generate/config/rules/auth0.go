@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+// Auth0 management API tokens are JWTs, which are reported by the jwt rule.
+func Auth0ClientSecret() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "auth0-client-secret",
+		Description: "Detected an Auth0 client secret, which can be exchanged for management API tokens of the Auth0 tenant.",
+		Regex: generateSemiGenericRegex([]string{"auth0"},
+			alphaNumericExtendedShort("64"), true),
+		Keywords: []string{
+			"auth0",
+		},
+		// 64 characters of [a-z0-9_-], the tps score 4.4-4.6. Hex strings
+		// score 4 at most and descriptive placeholders such as
+		// your_auth0_client_secret_goes_here_xxx... about 3
+		Entropy: 4,
+		Tags:    []string{"auth0", "identity"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("auth0", secrets.NewSecret(alphaNumericExtendedShort("64"))),
+		"AUTH0_CLIENT_SECRET=" + secrets.NewSecret(alphaNumericExtendedShort("64")),
+	}
+	fps := []string{
+		// not near an auth0 keyword
+		`client_secret = "` + secrets.NewSecret(alphaNumericExtendedShort("64")) + `"`,
+		// truncated secret
+		"AUTH0_CLIENT_SECRET=" + secrets.NewSecret(alphaNumericExtendedShort("60")),
+		"AUTH0_CLIENT_SECRET=" + secrets.NewSecret(`x{64}`),
+	}
+	return validate(r, tps, fps)
+}
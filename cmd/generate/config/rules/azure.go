@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func AzureStorage() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "azure-storage-account-key",
+		Description: "Identified an Azure Storage account key, which grants full access to the blobs, queues and tables of the storage account.",
+		Regex: generateSemiGenericRegex([]string{"accountkey", "azure", "storage"},
+			`[a-z0-9+/]{86}==`, true),
+		Keywords: []string{
+			"accountkey",
+			"azure",
+			"storage",
+		},
+		// 88 base64 characters, the tps score 5.3 and placeholders such as
+		// AAAA... 0
+		Entropy: 4,
+		Tags:    []string{"azure", "cloud"},
+	}
+
+	// validate
+	key := secrets.NewSecret(`[A-Za-z0-9+/]{86}==`)
+	tps := []string{
+		"DefaultEndpointsProtocol=https;AccountName=examplestore;AccountKey=" + key + ";EndpointSuffix=core.windows.net",
+		`"ConnectionString": "DefaultEndpointsProtocol=https;AccountName=examplestore;AccountKey=` + key + `"`,
+		generateSampleSecret("azure_storage", key),
+		"AZURE_STORAGE_KEY=" + key,
+	}
+	fps := []string{
+		// truncated key
+		"AccountKey=" + key[:80] + "==",
+		// not near an azure or storage keyword
+		`signature = "` + key + `"`,
+		// placeholder
+		"AZURE_STORAGE_KEY=" + secrets.NewSecret(`A{86}==`),
+	}
+	return validate(r, tps, fps)
+}
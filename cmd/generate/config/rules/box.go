@@ -0,0 +1,35 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func BoxDeveloperToken() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "box-developer-token",
+		Description: "Detected a Box developer token, which grants access to the files and folders of the Box user that created it.",
+		// box is a common word, so it must not be the end of another word
+		// such as checkbox
+		Regex:    generateSemiGenericRegex([]string{`\bbox`}, alphaNumeric("32"), true),
+		Keywords: []string{"box"},
+		// 32 alphanumeric characters, the tps score 4.2-4.6, so 3.5 still
+		// skips words and repeated characters
+		Entropy: 3.5,
+		Tags:    []string{"box", "storage"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("box", secrets.NewSecret(alphaNumeric("32"))),
+		"BOX_DEVELOPER_TOKEN=" + secrets.NewSecret(`[A-Za-z0-9]{32}`),
+	}
+	fps := []string{
+		generateSampleSecret("checkbox", secrets.NewSecret(alphaNumeric("32"))),
+		// truncated token
+		"BOX_DEVELOPER_TOKEN=" + secrets.NewSecret(`[A-Za-z0-9]{30}`),
+		"BOX_DEVELOPER_TOKEN=" + secrets.NewSecret(`x{32}`),
+	}
+	return validate(r, tps, fps)
+}
@@ -23,3 +23,62 @@ func DatadogtokenAccessToken() *config.Rule {
 	}
 	return validate(r, tps, nil)
 }
+
+func DatadogAPIKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "datadog-api-key",
+		Description: "Detected a Datadog API key, which allows submitting metrics, logs and events to a Datadog organization.",
+		Regex:       generateSemiGenericRegex([]string{"datadog", "dd_api"}, hex("32"), true),
+		Keywords: []string{
+			"datadog",
+			"dd_api",
+		},
+		// 32 hex characters, at most 4 bits each. The tps score 3.6-3.7,
+		// 2.5 only skips repetitive placeholders such as 0000... or abab...
+		Entropy: 2.5,
+		Tags:    []string{"datadog", "observability"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("datadog", secrets.NewSecret(hex("32"))),
+		"DD_API_KEY=" + secrets.NewSecret(hex("32")),
+		`datadog_api_key: "` + secrets.NewSecret(hex("32")) + `"`,
+	}
+	fps := []string{
+		// md5 of a file, not near a datadog keyword
+		`checksum = "` + secrets.NewSecret(hex("32")) + `"`,
+		"DD_API_KEY=00000000000000000000000000000000",
+	}
+	return validate(r, tps, fps)
+}
+
+func DatadogAppKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "datadog-app-key",
+		Description: "Detected a Datadog application key, which together with an API key grants access to the Datadog API on behalf of a user.",
+		Regex:       generateSemiGenericRegex([]string{"dd_app", "datadog_app"}, hex("40"), true),
+		Keywords: []string{
+			"dd_app",
+			"datadog_app",
+		},
+		// 40 hex characters, the tps score 3.6-3.8 and placeholders such
+		// as ffff... score 0
+		Entropy: 3,
+		Tags:    []string{"datadog", "observability"},
+	}
+
+	// validate
+	tps := []string{
+		"DD_APP_KEY=" + secrets.NewSecret(hex("40")),
+		`datadog_application_key = "` + secrets.NewSecret(hex("40")) + `"`,
+	}
+	fps := []string{
+		// sha1 of a commit, not near a datadog keyword
+		`commit = "` + secrets.NewSecret(hex("40")) + `"`,
+		"DD_APP_KEY=0000000000000000000000000000000000000000",
+	}
+	return validate(r, tps, fps)
+}
@@ -7,16 +7,25 @@ import (
 
 func DigitalOceanPAT() *config.Rule {
 	r := config.Rule{
-		Description: "Discovered a DigitalOcean Personal Access Token, posing a threat to cloud infrastructure security and data privacy.",
-		RuleID:      "digitalocean-pat",
-		Regex:       generateUniqueTokenRegex(`dop_v1_[a-f0-9]{64}`, true),
-		Keywords:    []string{"dop_v1_"},
+		Description:    "Discovered a DigitalOcean Personal Access Token, posing a threat to cloud infrastructure security and data privacy.",
+		RuleID:         "digitalocean-pat",
+		RemediationURL: "https://docs.digitalocean.com/reference/api/create-personal-access-token/",
+		Regex:          generateUniqueTokenRegex(`dop_v1_[a-f0-9]{64}`, true),
+		Keywords:       []string{"dop_v1_"},
+		// skips placeholders such as dop_v1_000...
+		Entropy: 3,
+		Tags:    []string{"digitalocean", "cloud"},
 	}
 
 	tps := []string{
 		generateSampleSecret("do", "dop_v1_"+secrets.NewSecret(hex("64"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// sha256 without the token prefix
+		generateSampleSecret("do", secrets.NewSecret(hex("64"))),
+		generateSampleSecret("do", "dop_v1_"+secrets.NewSecret(`0{64}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func DigitalOceanOAuthToken() *config.Rule {
@@ -26,12 +35,20 @@ func DigitalOceanOAuthToken() *config.Rule {
 
 		Regex:    generateUniqueTokenRegex(`doo_v1_[a-f0-9]{64}`, true),
 		Keywords: []string{"doo_v1_"},
+		// skips placeholders such as doo_v1_000...
+		Entropy: 3,
+		Tags:    []string{"digitalocean", "cloud"},
 	}
 
 	tps := []string{
 		generateSampleSecret("do", "doo_v1_"+secrets.NewSecret(hex("64"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// sha256 without the token prefix
+		generateSampleSecret("do", secrets.NewSecret(hex("64"))),
+		generateSampleSecret("do", "doo_v1_"+secrets.NewSecret(`0{64}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func DigitalOceanRefreshToken() *config.Rule {
@@ -41,10 +58,18 @@ func DigitalOceanRefreshToken() *config.Rule {
 
 		Regex:    generateUniqueTokenRegex(`dor_v1_[a-f0-9]{64}`, true),
 		Keywords: []string{"dor_v1_"},
+		// skips placeholders such as dor_v1_000...
+		Entropy: 3,
+		Tags:    []string{"digitalocean", "cloud"},
 	}
 
 	tps := []string{
 		generateSampleSecret("do", "dor_v1_"+secrets.NewSecret(hex("64"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// sha256 without the token prefix
+		generateSampleSecret("do", secrets.NewSecret(hex("64"))),
+		generateSampleSecret("do", "dor_v1_"+secrets.NewSecret(`0{64}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"encoding/base64"
+	"regexp"
+
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func DockerHubPAT() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "docker-hub-pat",
+		Description: "Detected a Docker Hub personal access token, which allows pushing and pulling images of the account's repositories.",
+		Regex:       generateUniqueTokenRegex(`dckr_pat_[a-z0-9_-]{27,}`, true),
+		Keywords:    []string{"dckr_pat_"},
+		// skips placeholders such as dckr_pat_xxx...
+		Entropy: 3,
+		Tags:    []string{"docker", "registry"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("docker", "dckr_pat_"+secrets.NewSecret(`[A-Za-z0-9_-]{27}`)),
+		"echo dckr_pat_" + secrets.NewSecret(`[A-Za-z0-9_-]{27}`) + " | docker login -u ci --password-stdin",
+	}
+	fps := []string{
+		// truncated token
+		generateSampleSecret("docker", "dckr_pat_"+secrets.NewSecret(`[A-Za-z0-9_-]{20}`)),
+		generateSampleSecret("docker", "dckr_pat_xxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+	}
+	return validate(r, tps, fps)
+}
+
+// The auth field of a docker config.json or .dockercfg is the base64 of
+// user:password. Scan with --decode-base64 to also check the decoded
+// password against the other rules.
+func DockerConfigAuth() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "docker-config-auth",
+		Description: "Found registry credentials in the auth field of a Docker config, which decode to the user name and password of the registry.",
+		Regex:       regexp.MustCompile(`"auth"\s*:\s*"([A-Za-z0-9+/]{8,}={0,2})"`),
+		// the auths object is specific to docker configs
+		Keywords: []string{"auths"},
+		// base64 of user:password, the tps score 4.5 and even
+		// base64("user:password") scores 3.9, a run of one character 0
+		Entropy: 3,
+		Tags:    []string{"docker", "registry"},
+	}
+
+	// validate
+	auth := base64.StdEncoding.EncodeToString([]byte("ci-bot:" + secrets.NewSecret(alphaNumeric("20"))))
+	tps := []string{
+		`{"auths":{"https://index.docker.io/v1/":{"auth":"` + auth + `"}}}`,
+		`{
+	"auths": {
+		"registry.example.com": {
+			"auth": "` + auth + `"
+		}
+	}
+}`,
+	}
+	fps := []string{
+		// not a docker config
+		`{"auth":"` + auth + `"}`,
+		`{"auths":{"registry.example.com":{"auth":""}}}`,
+		`{"auths":{"registry.example.com":{"auth":"AAAAAAAAAAAA"}}}`,
+	}
+	return validate(r, tps, fps)
+}
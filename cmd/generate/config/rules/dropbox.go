@@ -27,12 +27,24 @@ func DropBoxShortLivedAPIToken() *config.Rule {
 	r := config.Rule{
 		RuleID:      "dropbox-short-lived-api-token",
 		Description: "Discovered a Dropbox short-lived API token, posing a risk of temporary but potentially harmful data access and manipulation.",
-		Regex:       generateSemiGenericRegex([]string{"dropbox"}, `sl\.[a-z0-9\-=_]{135}`, true),
+		Regex:       generateSemiGenericRegex([]string{"dropbox"}, `sl\.[a-z0-9\-=_]{130,}`, true),
 		Keywords:    []string{"dropbox"},
+		// sl. and 130 or more characters, the tps score 5.7
+		Entropy: 3.5,
+		Tags:    []string{"dropbox", "storage"},
 	}
 
-	// validate TODO
-	return &r
+	// validate
+	tps := []string{
+		generateSampleSecret("dropbox", "sl."+secrets.NewSecret(`[A-Za-z0-9\-_]{135}`)),
+		"DROPBOX_ACCESS_TOKEN=sl." + secrets.NewSecret(`[A-Za-z0-9\-_]{140}`),
+	}
+	fps := []string{
+		// truncated token
+		generateSampleSecret("dropbox", "sl."+secrets.NewSecret(`[A-Za-z0-9\-_]{100}`)),
+		generateSampleSecret("dropbox", "sl."+secrets.NewSecret(`x{135}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func DropBoxLongLivedAPIToken() *config.Rule {
@@ -40,10 +52,22 @@ func DropBoxLongLivedAPIToken() *config.Rule {
 	r := config.Rule{
 		RuleID:      "dropbox-long-lived-api-token",
 		Description: "Found a Dropbox long-lived API token, risking prolonged unauthorized access to cloud storage and sensitive data.",
-		Regex:       generateSemiGenericRegex([]string{"dropbox"}, `[a-z0-9]{11}(AAAAAAAAAA)[a-z0-9\-_=]{43}`, true),
+		Regex:       generateSemiGenericRegex([]string{"dropbox"}, `[a-z0-9]{11}(?:AAAAAAAAAA)[a-z0-9\-_=]{43}`, true),
 		Keywords:    []string{"dropbox"},
+		// 64 characters including a run of 10 A's, which lowers the
+		// entropy, the tps still score 4.7
+		Entropy: 3,
+		Tags:    []string{"dropbox", "storage"},
 	}
 
-	// validate TODO
-	return &r
+	// validate
+	tps := []string{
+		generateSampleSecret("dropbox", secrets.NewSecret(alphaNumeric("11"))+"AAAAAAAAAA"+secrets.NewSecret(`[A-Za-z0-9\-_]{43}`)),
+	}
+	fps := []string{
+		// 64 characters without the AAAAAAAAAA marker
+		generateSampleSecret("dropbox", secrets.NewSecret(`[b-z]{64}`)),
+		generateSampleSecret("dropbox", secrets.NewSecret(alphaNumeric("11"))+"AAAAAAAAAA"+secrets.NewSecret(`A{43}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -12,9 +12,12 @@ func FacebookSecret() *config.Rule {
 	r := config.Rule{
 		Description: "Discovered a Facebook Application secret, posing a risk of unauthorized access to Facebook accounts and personal data exposure.",
 		RuleID:      "facebook-secret",
-		Regex:       generateSemiGenericRegex([]string{"facebook"}, hex("32"), true),
+		Regex:       generateSemiGenericRegex([]string{"facebook", `\bfb[_.\-]`}, hex("32"), true),
 
-		Keywords: []string{"facebook"},
+		Keywords: []string{"facebook", "fb"},
+		// 32 hex characters, the tps score 3.5-3.6
+		Entropy: 2.5,
+		Tags:    []string{"facebook", "social"},
 	}
 
 	// validate
@@ -22,8 +25,15 @@ func FacebookSecret() *config.Rule {
 		generateSampleSecret("facebook", secrets.NewSecret(hex("32"))),
 		`facebook_app_secret = "6dca6432e45d933e13650d1882bd5e69"`,       // gitleaks:allow
 		`facebook_client_access_token: 26f5fd13099f2c1331aafb86f6489692`, // gitleaks:allow
+		"FB_APP_SECRET=" + secrets.NewSecret(hex("32")),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// md5 of a file, not near a facebook keyword
+		`md5 = "` + secrets.NewSecret(hex("32")) + `"`,
+		`fbx_model_hash = "` + secrets.NewSecret(hex("32")) + `"`,
+		"FB_APP_SECRET=" + secrets.NewSecret(`0{32}`),
+	}
+	return validate(r, tps, fps)
 }
 
 // https://developers.facebook.com/docs/facebook-login/guides/access-tokens/#apptokens
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func SensitiveFilenames() *config.Rule {
+	// define rule
+	r := config.Rule{
+		Description: "Detected a file whose name suggests it holds credentials or private keys, such as an SSH key, certificate bundle or dotenv file.",
+		RuleID:      "sensitive-filename",
+		Path:        regexp.MustCompile(`(?i)(?:(?:^|/)(?:id_(?:rsa|dsa|ecdsa|ed25519)|credentials)|\.(?:env|pem|pfx|p12))$`),
+		// empty placeholder files are not worth reporting
+		RequireContent: true,
+		// without a content match every dotenv file and public certificate
+		// is reported, once for each commit that changes it
+		Tags: []string{config.TagDefaultDisabled, "filename"},
+	}
+
+	// validate
+	tps := []string{
+		"secrets/id_rsa",
+		"home/.ssh/id_ed25519",
+		".aws/credentials",
+		".env",
+		"deploy/prod.env",
+		"certs/server.pem",
+		"certs/client.PFX",
+		"keystore.p12",
+	}
+	fps := []string{
+		"README.md",
+		"secrets/id_rsa.pub",
+		".env.example",
+		"credentials.go",
+		"docs/environment.md",
+	}
+	return validatePath(r, tps, fps)
+}
@@ -13,13 +13,19 @@ func FinicityClientSecret() *config.Rule {
 		Regex:       generateSemiGenericRegex([]string{"finicity"}, alphaNumeric("20"), true),
 
 		Keywords: []string{"finicity"},
+		// 20 alphanumeric characters, the tps score 3.9
+		Entropy: 3,
+		Tags:    []string{"finicity", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("finicity", secrets.NewSecret(alphaNumeric("20"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("finicity", secrets.NewSecret(`x{20}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func FinicityAPIToken() *config.Rule {
@@ -30,11 +36,19 @@ func FinicityAPIToken() *config.Rule {
 		Regex:       generateSemiGenericRegex([]string{"finicity"}, hex("32"), true),
 
 		Keywords: []string{"finicity"},
+		// 32 hex characters, the tps score 3.5
+		Entropy: 2.5,
+		Tags:    []string{"finicity", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("finicity", secrets.NewSecret(hex("32"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// md5 of a file, not near a finicity keyword
+		`md5 = "` + secrets.NewSecret(hex("32")) + `"`,
+		generateSampleSecret("finicity", secrets.NewSecret(`0{32}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -11,10 +11,11 @@ import (
 func GCPServiceAccount() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Google (GCP) Service-account",
-		RuleID:      "gcp-service-account",
-		Regex:       regexp.MustCompile(`\"type\": \"service_account\"`),
-		Keywords:    []string{`\"type\": \"service_account\"`},
+		Description:    "Google (GCP) Service-account",
+		RuleID:         "gcp-service-account",
+		RemediationURL: "https://cloud.google.com/iam/docs/keys-create-delete",
+		Regex:          regexp.MustCompile(`\"type\": \"service_account\"`),
+		Keywords:       []string{`\"type\": \"service_account\"`},
 	}
 
 	// validate
@@ -27,9 +28,10 @@ func GCPServiceAccount() *config.Rule {
 func GCPAPIKey() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "gcp-api-key",
-		Description: "Uncovered a GCP API key, which could lead to unauthorized access to Google Cloud services and data breaches.",
-		Regex:       generateUniqueTokenRegex(`AIza[0-9A-Za-z\\-_]{35}`, true),
+		RuleID:         "gcp-api-key",
+		Description:    "Uncovered a GCP API key, which could lead to unauthorized access to Google Cloud services and data breaches.",
+		RemediationURL: "https://cloud.google.com/docs/authentication/api-keys",
+		Regex:          generateUniqueTokenRegex(`AIza[0-9A-Za-z\\-_]{35}`, true),
 
 		Keywords: []string{
 			"AIza",
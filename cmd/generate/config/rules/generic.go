@@ -35,6 +35,7 @@ func GenericCredential() *config.Rule {
 		Allowlist: config.Allowlist{
 			StopWords: DefaultStopWords,
 		},
+		Tags: []string{"generic"},
 	}
 
 	// validate
@@ -43,12 +44,27 @@ func GenericCredential() *config.Rule {
 		generateSampleSecret("generic", "Zf3D0LXCM3EIMbgJpUNnkRtOfOueHznB"),
 		`"client_id" : "0afae57f3ccfd9d7f5767067bc48b30f719e271ba470488056e37ab35d4b6506"`,
 		`"client_secret" : "6da89121079f83b2eb6acccf8219ea982c3d79bccc3e9c6a85856480661f8fde",`,
+		`apikey = "sk9fQ2LmZr8VtXp3WbN7cY1d"`,
+		`access-token: 'Q7vKd93LmWz0pRt2XyB8nC4h'`,
 	}
 	fps := []string{
 		`client_vpn_endpoint_id = aws_ec2_client_vpn_endpoint.client-vpn-endpoint.id`,
 		`password combination.
 
 R5: Regulatory--21`,
+		// empty values, references to other variables and template
+		// placeholders are not secrets
+		`api_key = ""`,
+		`apiKey: process.env.API_KEY`,
+		`api-key: settings.API_KEY`,
+		`access_token = config.get_access_token`,
+		`apiKey: os.environ.get("API_KEY")`,
+		`api_key = "${API_KEY}"`,
+		`access_token: <token>`,
+		`api_key = "<your-api-key>"`,
+		`access_token = "{{ access_token }}"`,
+		`api_key = "your_api_key_here"`,
+		`apiKey = "xxxxxxxxxxxxxxxxxxxxxxxx"`,
 	}
 	return validate(r, tps, fps)
 }
@@ -10,10 +10,11 @@ import (
 func GitHubPat() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Uncovered a GitHub Personal Access Token, potentially leading to unauthorized repository access and sensitive content exposure.",
-		RuleID:      "github-pat",
-		Regex:       regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`),
-		Keywords:    []string{"ghp_"},
+		Description:    "Uncovered a GitHub Personal Access Token, potentially leading to unauthorized repository access and sensitive content exposure.",
+		RuleID:         "github-pat",
+		RemediationURL: "https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/managing-your-personal-access-tokens",
+		Regex:          regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`),
+		Keywords:       []string{"ghp_"},
 	}
 
 	// validate
@@ -26,10 +27,11 @@ func GitHubPat() *config.Rule {
 func GitHubFineGrainedPat() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a GitHub Fine-Grained Personal Access Token, risking unauthorized repository access and code manipulation.",
-		RuleID:      "github-fine-grained-pat",
-		Regex:       regexp.MustCompile(`github_pat_[0-9a-zA-Z_]{82}`),
-		Keywords:    []string{"github_pat_"},
+		Description:    "Found a GitHub Fine-Grained Personal Access Token, risking unauthorized repository access and code manipulation.",
+		RuleID:         "github-fine-grained-pat",
+		RemediationURL: "https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/managing-your-personal-access-tokens",
+		Regex:          regexp.MustCompile(`github_pat_[0-9a-zA-Z_]{82}`),
+		Keywords:       []string{"github_pat_"},
 	}
 
 	// validate
@@ -42,10 +44,11 @@ func GitHubFineGrainedPat() *config.Rule {
 func GitHubOauth() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Discovered a GitHub OAuth Access Token, posing a risk of compromised GitHub account integrations and data leaks.",
-		RuleID:      "github-oauth",
-		Regex:       regexp.MustCompile(`gho_[0-9a-zA-Z]{36}`),
-		Keywords:    []string{"gho_"},
+		Description:    "Discovered a GitHub OAuth Access Token, posing a risk of compromised GitHub account integrations and data leaks.",
+		RuleID:         "github-oauth",
+		RemediationURL: "https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/token-expiration-and-revocation",
+		Regex:          regexp.MustCompile(`gho_[0-9a-zA-Z]{36}`),
+		Keywords:       []string{"gho_"},
 	}
 
 	// validate
@@ -58,10 +61,11 @@ func GitHubOauth() *config.Rule {
 func GitHubApp() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Identified a GitHub App Token, which may compromise GitHub application integrations and source code security.",
-		RuleID:      "github-app-token",
-		Regex:       regexp.MustCompile(`(ghu|ghs)_[0-9a-zA-Z]{36}`),
-		Keywords:    []string{"ghu_", "ghs_"},
+		Description:    "Identified a GitHub App Token, which may compromise GitHub application integrations and source code security.",
+		RuleID:         "github-app-token",
+		RemediationURL: "https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/token-expiration-and-revocation",
+		Regex:          regexp.MustCompile(`(ghu|ghs)_[0-9a-zA-Z]{36}`),
+		Keywords:       []string{"ghu_", "ghs_"},
 	}
 
 	// validate
@@ -75,10 +79,11 @@ func GitHubApp() *config.Rule {
 func GitHubRefresh() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Detected a GitHub Refresh Token, which could allow prolonged unauthorized access to GitHub services.",
-		RuleID:      "github-refresh-token",
-		Regex:       regexp.MustCompile(`ghr_[0-9a-zA-Z]{36}`),
-		Keywords:    []string{"ghr_"},
+		Description:    "Detected a GitHub Refresh Token, which could allow prolonged unauthorized access to GitHub services.",
+		RuleID:         "github-refresh-token",
+		RemediationURL: "https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/token-expiration-and-revocation",
+		Regex:          regexp.MustCompile(`ghr_[0-9a-zA-Z]{36}`),
+		Keywords:       []string{"ghr_"},
 	}
 
 	// validate
@@ -10,10 +10,11 @@ import (
 func GitlabPat() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Identified a GitLab Personal Access Token, risking unauthorized access to GitLab repositories and codebase exposure.",
-		RuleID:      "gitlab-pat",
-		Regex:       regexp.MustCompile(`glpat-[0-9a-zA-Z\-\_]{20}`),
-		Keywords:    []string{"glpat-"},
+		Description:    "Identified a GitLab Personal Access Token, risking unauthorized access to GitLab repositories and codebase exposure.",
+		RuleID:         "gitlab-pat",
+		RemediationURL: "https://docs.gitlab.com/ee/user/profile/personal_access_tokens.html",
+		Regex:          regexp.MustCompile(`glpat-[0-9a-zA-Z\-\_]{20}`),
+		Keywords:       []string{"glpat-"},
 	}
 
 	// validate
@@ -26,10 +27,11 @@ func GitlabPat() *config.Rule {
 func GitlabPipelineTriggerToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a GitLab Pipeline Trigger Token, potentially compromising continuous integration workflows and project security.",
-		RuleID:      "gitlab-ptt",
-		Regex:       regexp.MustCompile(`glptt-[0-9a-f]{40}`),
-		Keywords:    []string{"glptt-"},
+		Description:    "Found a GitLab Pipeline Trigger Token, potentially compromising continuous integration workflows and project security.",
+		RuleID:         "gitlab-ptt",
+		RemediationURL: "https://docs.gitlab.com/ee/ci/triggers/",
+		Regex:          regexp.MustCompile(`glptt-[0-9a-f]{40}`),
+		Keywords:       []string{"glptt-"},
 	}
 
 	// validate
@@ -42,10 +44,11 @@ func GitlabPipelineTriggerToken() *config.Rule {
 func GitlabRunnerRegistrationToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Discovered a GitLab Runner Registration Token, posing a risk to CI/CD pipeline integrity and unauthorized access.",
-		RuleID:      "gitlab-rrt",
-		Regex:       regexp.MustCompile(`GR1348941[0-9a-zA-Z\-\_]{20}`),
-		Keywords:    []string{"GR1348941"},
+		Description:    "Discovered a GitLab Runner Registration Token, posing a risk to CI/CD pipeline integrity and unauthorized access.",
+		RuleID:         "gitlab-rrt",
+		RemediationURL: "https://docs.gitlab.com/ee/ci/runners/",
+		Regex:          regexp.MustCompile(`GR1348941[0-9a-zA-Z\-\_]{20}`),
+		Keywords:       []string{"GR1348941"},
 	}
 
 	// validate
@@ -16,11 +16,20 @@ func GoCardless() *config.Rule {
 			"live_",
 			"gocardless",
 		},
+		// skips placeholders such as live_xxx...
+		Entropy: 3,
+		Tags:    []string{"gocardless", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("gocardless", "live_"+secrets.NewSecret(alphaNumericExtended("40"))),
+		"GOCARDLESS_ACCESS_TOKEN=live_" + secrets.NewSecret(`[A-Za-z0-9_=\-]{40}`),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// sandbox tokens cannot move money
+		"GOCARDLESS_ACCESS_TOKEN=sandbox_" + secrets.NewSecret(`[A-Za-z0-9_=\-]{40}`),
+		"GOCARDLESS_ACCESS_TOKEN=live_" + secrets.NewSecret(`x{40}`),
+	}
+	return validate(r, tps, fps)
 }
@@ -13,6 +13,9 @@ func GrafanaApiKey() *config.Rule {
 
 		Regex:    generateUniqueTokenRegex(`eyJrIjoi[A-Za-z0-9]{70,400}={0,2}`, true),
 		Keywords: []string{"eyJrIjoi"},
+		// base64 json starting with eyJrIjoi, the tps score 4.7
+		Entropy: 3,
+		Tags:    []string{"grafana", "observability"},
 	}
 
 	// validate
@@ -21,7 +24,11 @@ func GrafanaApiKey() *config.Rule {
 			"eyJrIjoi"+
 				secrets.NewSecret(alphaNumeric("70"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated key
+		generateSampleSecret("grafana-api-key", "eyJrIjoi"+secrets.NewSecret(alphaNumeric("40"))),
+	}
+	return validate(r, tps, fps)
 }
 
 func GrafanaCloudApiToken() *config.Rule {
@@ -32,6 +39,9 @@ func GrafanaCloudApiToken() *config.Rule {
 
 		Regex:    generateUniqueTokenRegex(`glc_[A-Za-z0-9+/]{32,400}={0,2}`, true),
 		Keywords: []string{"glc_"},
+		// glc_ and 32 or more base64 characters, the tps score 4.5
+		Entropy: 3,
+		Tags:    []string{"grafana", "observability"},
 	}
 
 	// validate
@@ -40,7 +50,12 @@ func GrafanaCloudApiToken() *config.Rule {
 			"glc_"+
 				secrets.NewSecret(alphaNumeric("32"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated token
+		generateSampleSecret("grafana-cloud-api-token", "glc_"+secrets.NewSecret(alphaNumeric("20"))),
+		generateSampleSecret("grafana-cloud-api-token", "glc_"+secrets.NewSecret(`x{32}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func GrafanaServiceAccountToken() *config.Rule {
@@ -51,6 +66,10 @@ func GrafanaServiceAccountToken() *config.Rule {
 
 		Regex:    generateUniqueTokenRegex(`glsa_[A-Za-z0-9]{32}_[A-Fa-f0-9]{8}`, true),
 		Keywords: []string{"glsa_"},
+		// glsa_, 32 alphanumeric characters and an 8 character hex
+		// checksum, the tps score 4.5-4.8
+		Entropy: 3,
+		Tags:    []string{"grafana", "observability"},
 	}
 
 	// validate
@@ -60,6 +79,12 @@ func GrafanaServiceAccountToken() *config.Rule {
 				secrets.NewSecret(alphaNumeric("32"))+
 				"_"+
 				secrets.NewSecret((hex("8")))),
+		"GF_SECURITY_SERVICE_ACCOUNT_TOKEN=glsa_" + secrets.NewSecret(`[A-Za-z0-9]{32}`) + "_" + secrets.NewSecret(hex("8")),
+	}
+	fps := []string{
+		// missing the checksum
+		generateSampleSecret("grafana-service-account-token", "glsa_"+secrets.NewSecret(alphaNumeric("32"))),
+		generateSampleSecret("grafana-service-account-token", "glsa_"+secrets.NewSecret(`x{32}`)+"_"+secrets.NewSecret(`0{8}`)),
 	}
-	return validate(r, tps, nil)
+	return validate(r, tps, fps)
 }
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
 
@@ -12,6 +13,9 @@ func Heroku() *config.Rule {
 		Regex:       generateSemiGenericRegex([]string{"heroku"}, hex8_4_4_4_12(), true),
 
 		Keywords: []string{"heroku"},
+		// skips placeholders such as 00000000-0000-...
+		Entropy: 3,
+		Tags:    []string{"heroku", "paas"},
 	}
 
 	// validate
@@ -19,5 +23,10 @@ func Heroku() *config.Rule {
 		`const HEROKU_KEY = "12345678-ABCD-ABCD-ABCD-1234567890AB"`, // gitleaks:allow
 		`heroku_api_key = "832d2129-a846-4e27-99f4-7004b6ad53ef"`,   // gitleaks:allow
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// a uuid not near a heroku keyword
+		`request_id = "` + secrets.NewSecret(hex8_4_4_4_12()) + `"`,
+		`HEROKU_API_KEY=00000000-0000-0000-0000-000000000000`,
+	}
+	return validate(r, tps, fps)
 }
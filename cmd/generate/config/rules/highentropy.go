@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+// hashContext matches lines that name a hash, checksum or identifier. Long
+// hex and base64 strings on those lines are digests, not secrets.
+var hashContext = regexp.MustCompile(`(?i)(?:sha-?(?:1|224|256|384|512)?|md5|checksum|digest|hash|commit|integrity|revision|etag|fingerprint|uuid|guid|nonce)`)
+
+// highEntropyStopWords are words of placeholders that are long and random
+// enough to pass the entropy checks, e.g. base64 of an example value.
+var highEntropyStopWords = []string{
+	"example",
+	"sample",
+	"dummy",
+	"placeholder",
+	"changeme",
+	"redacted",
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+}
+
+func HighEntropyBase64() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "high-entropy-base64",
+		Description: "Found a long, high entropy base64 string that may be a secret of an unknown provider.",
+		// 40 to 120 characters, unpadded or padded, not part of a longer
+		// word, path or file name
+		Regex:       regexp.MustCompile(`(?:^|[^0-9A-Za-z+/_.-])([A-Za-z0-9+/]{40,120}={0,2})(?:[^0-9A-Za-z+/=_.-]|$)`),
+		SecretGroup: 1,
+		// random base64 of 40 characters has an entropy around 5, hex and
+		// natural language stay below 4.5
+		Entropy: 4.5,
+		Allowlist: config.Allowlist{
+			RegexTarget: "line",
+			Regexes:     []*regexp.Regexp{hashContext},
+			StopWords:   highEntropyStopWords,
+		},
+		Tags: []string{config.TagDefaultDisabled, "generic", "high-entropy"},
+	}
+
+	// validate
+	tps := []string{
+		`secret = "q8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz"`,
+		`export UPSTREAM_TOKEN=Xn4kR9pLq2Vw7ZbT1MyC6HdJ3FsG8Ae5UoIxQlWr0Kc=`,
+		`{"value": "t7Qm2Zx9Kc4Vb8Np1Lr6Ws3Jd0Hf5Gy+Ua/Eo7Ti2Rk9Mp4Qz8Xn1Cv6Bb3"}`,
+	}
+	fps := []string{
+		// hex is only base64 in name
+		`token = "3f786850e387550fdab836ed7e6dc881de23001b3f786850e387550f"`,
+		// subresource integrity and other digests
+		`"integrity": "sha512-q8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz+Xn4kR9pLq2Vw7ZbT1MyC6HdJ3FsG8Ae5UoIxQlWr0Kc=="`,
+		`checksum: q8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz`,
+		// identifiers and paths have low entropy
+		`AbstractSingletonProxyFactoryBeanConfigurationProvider`,
+		`src/main/java/org/springframework/context/annotation/Configuration`,
+		`aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa`,
+		// placeholders
+		`key = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"`,
+		`key = "PLACEHOLDERq8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz"`,
+		// too short or too long
+		`secret = "q8ZgT3vKp1XwLr7NmYb2CdFh6Js"`,
+		`q8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pzq8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pzq8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pzq8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz`,
+		// a word of a url or identifier
+		`https://example.com/q8ZgT3vKp1XwLr7NmYb2CdFh6JsQa9Ue4ViOt0Pz.png`,
+	}
+	return validate(r, tps, fps)
+}
+
+func HighEntropyHex() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "high-entropy-hex",
+		Description: "Found a long, high entropy hex string that may be a secret of an unknown provider.",
+		// 32 to 128 characters, except 40 which is the length of git
+		// commit hashes
+		Regex:       regexp.MustCompile(`(?:^|[^0-9A-Za-z_-])([a-f0-9]{41,128}|[a-f0-9]{32,39}|[A-F0-9]{41,128}|[A-F0-9]{32,39})(?:[^0-9A-Za-z_-]|$)`),
+		SecretGroup: 1,
+		// random hex of 32 characters has an entropy around 3.8, the
+		// maximum is 4
+		Entropy: 3.5,
+		Allowlist: config.Allowlist{
+			RegexTarget: "line",
+			Regexes:     []*regexp.Regexp{hashContext},
+		},
+		Tags: []string{config.TagDefaultDisabled, "generic", "high-entropy"},
+	}
+
+	// validate
+	tps := []string{
+		`secret = "8f3a2c9d7e1b4f6a0c5d8e2f9a7b3c1d"`,
+		`SIGNING_KEY=4a7f9c2e8b1d6f3a0e5c9b7d2f8a1e4c6b3d9f0a7e2c5b8d1f4a6e9c3b7d0f2a`,
+		`api.setCredentials("D4E7A1C9F3B8025E6D1A9C7F4B2E8D03")`,
+	}
+	fps := []string{
+		// git commit hashes
+		`3f786850e387550fdab836ed7e6dc881de23001b`,
+		`git checkout 3f786850e387550fdab836ed7e6dc881de23001b`,
+		// checksums
+		`sha256: 4a7f9c2e8b1d6f3a0e5c9b7d2f8a1e4c6b3d9f0a7e2c5b8d1f4a6e9c3b7d0f2a`,
+		`4a7f9c2e8b1d6f3a0e5c9b7d2f8a1e4c  archive.tar.gz (MD5)`,
+		`"etag": "8f3a2c9d7e1b4f6a0c5d8e2f9a7b3c1d"`,
+		// uuids
+		`id = "8f3a2c9d-7e1b-4f6a-0c5d-8e2f9a7b3c1d"`,
+		// low entropy
+		`secret = "00000000000000000000000000000000"`,
+		`secret = "abababababababababababababababababab"`,
+		// too short
+		`secret = "8f3a2c9d7e1b4f6a0c5d8e2f9a"`,
+		// mixed case is not hex
+		`secret = "8f3a2c9D7e1b4f6A0c5d8e2F9a7b3c1d"`,
+	}
+	return validate(r, tps, fps)
+}
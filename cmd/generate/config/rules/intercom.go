@@ -10,14 +10,26 @@ func Intercom() *config.Rule {
 	r := config.Rule{
 		Description: "Identified an Intercom API Token, which could compromise customer communication channels and data privacy.",
 		RuleID:      "intercom-api-key",
-		Regex:       generateSemiGenericRegex([]string{"intercom"}, alphaNumericExtended("60"), true),
+		// access tokens are base64 and start with dG9rOj, the encoding of
+		// "tok:"
+		Regex: generateSemiGenericRegex([]string{"intercom"},
+			`(?:dG9rOj[a-z0-9+/=_\-]{40,}|`+alphaNumericExtended("60")+`)`, true),
 
 		Keywords: []string{"intercom"},
+		// base64 tokens of 60 characters, the tps score 4.9-5.1
+		Entropy: 3,
+		Tags:    []string{"intercom", "analytics"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("intercom", secrets.NewSecret(alphaNumericExtended("60"))),
+		"INTERCOM_ACCESS_TOKEN=dG9rOj" + secrets.NewSecret(`[A-Za-z0-9+/]{54}`) + "=",
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated token
+		"INTERCOM_ACCESS_TOKEN=dG9rOj" + secrets.NewSecret(`[A-Za-z0-9]{20}`),
+		generateSampleSecret("intercom", secrets.NewSecret(`x{60}`)),
+	}
+	return validate(r, tps, fps)
 }
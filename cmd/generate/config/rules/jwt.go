@@ -4,6 +4,7 @@ import (
 	b64 "encoding/base64"
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/zricethezav/gitleaks/v8/config"
 )
@@ -184,5 +185,8 @@ func generateTestValues() []string {
 			examples = append(examples, b64.RawURLEncoding.EncodeToString(jwt))
 		}
 	}
+	// maps are iterated in random order, the examples end up in the
+	// generated config
+	sort.Strings(examples)
 	return examples
 }
@@ -16,6 +16,9 @@ func KrakenAccessToken() *config.Rule {
 		Keywords: []string{
 			"kraken",
 		},
+		// 80 to 90 base64 characters, the tps score 4.9
+		Entropy: 3.5,
+		Tags:    []string{"kraken", "crypto"},
 	}
 
 	// validate
@@ -23,5 +26,8 @@ func KrakenAccessToken() *config.Rule {
 		generateSampleSecret("kraken",
 			secrets.NewSecret(alphaNumericExtendedLong("80,90"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("kraken", secrets.NewSecret(`x{88}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -15,13 +15,20 @@ func KucoinAccessToken() *config.Rule {
 		Keywords: []string{
 			"kucoin",
 		},
+		// 24 hex characters, the tps score 3.5. Short hex strings score
+		// lower than the 32 character ones, hence 2.5
+		Entropy: 2.5,
+		Tags:    []string{"kucoin", "crypto"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("kucoin", secrets.NewSecret(hex("24"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("kucoin", secrets.NewSecret(`0{24}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func KucoinSecretKey() *config.Rule {
@@ -34,11 +41,17 @@ func KucoinSecretKey() *config.Rule {
 		Keywords: []string{
 			"kucoin",
 		},
+		// uuids, the tps score 3.7 and the all zero uuid 0.5
+		Entropy: 3,
+		Tags:    []string{"kucoin", "crypto"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("kucoin", secrets.NewSecret(hex8_4_4_4_12())),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("kucoin", "00000000-0000-0000-0000-000000000000"),
+	}
+	return validate(r, tps, fps)
 }
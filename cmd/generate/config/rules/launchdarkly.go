@@ -23,3 +23,31 @@ func LaunchDarklyAccessToken() *config.Rule {
 	}
 	return validate(r, tps, nil)
 }
+
+func LaunchDarklyAPIKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "launchdarkly-api-key",
+		Description: "Detected a LaunchDarkly API access token, which can read and change feature flags through the LaunchDarkly REST API.",
+		// ld is short, so it must start a word, as in LD_API_KEY
+		Regex: generateSemiGenericRegex([]string{"launchdarkly", `\bld`},
+			"api-"+hex8_4_4_4_12(), true),
+		Keywords: []string{"api-"},
+		// api- and a uuid, the tps score 3.8 and the all zero uuid 1
+		Entropy: 3,
+		Tags:    []string{"launchdarkly", "devtools"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("launchdarkly", "api-"+secrets.NewSecret(hex8_4_4_4_12())),
+		"LD_API_KEY=api-" + secrets.NewSecret(hex8_4_4_4_12()),
+	}
+	fps := []string{
+		// sdk keys are embedded in server side applications by design
+		"LD_SDK_KEY=sdk-" + secrets.NewSecret(hex8_4_4_4_12()),
+		"WORLD_API_KEY=api-" + secrets.NewSecret(hex8_4_4_4_12()),
+		"LD_API_KEY=api-00000000-0000-0000-0000-000000000000",
+	}
+	return validate(r, tps, fps)
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func LinodeAPIToken() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "linode-api-token",
+		Description: "Detected a Linode personal access token, which grants access to the Linode API and the cloud resources of the account.",
+		Regex:       generateSemiGenericRegex([]string{"linode"}, hex("64"), true),
+		Keywords: []string{
+			"linode",
+		},
+		// 64 hex characters, the tps score 3.7-3.9 of at most 4
+		Entropy: 3,
+		Tags:    []string{"linode", "cloud"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("linode", secrets.NewSecret(hex("64"))),
+		"LINODE_TOKEN=" + secrets.NewSecret(hex("64")),
+		`linode_cli_token: "` + secrets.NewSecret(hex("64")) + `"`,
+	}
+	fps := []string{
+		// sha256 of a file, not near a linode keyword
+		`sha256 = "` + secrets.NewSecret(hex("64")) + `"`,
+		"LINODE_TOKEN=" + secrets.NewSecret(`0{64}`),
+	}
+	return validate(r, tps, fps)
+}
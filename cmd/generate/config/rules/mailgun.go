@@ -8,9 +8,10 @@ import (
 func MailGunPrivateAPIToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "mailgun-private-api-token",
-		Description: "Found a Mailgun private API token, risking unauthorized email service operations and data breaches.",
-		Regex:       generateSemiGenericRegex([]string{"mailgun"}, `key-[a-f0-9]{32}`, true),
+		RuleID:         "mailgun-private-api-token",
+		Description:    "Found a Mailgun private API token, risking unauthorized email service operations and data breaches.",
+		RemediationURL: "https://app.mailgun.com/settings/api_security",
+		Regex:          generateSemiGenericRegex([]string{"mailgun"}, `key-[a-f0-9]{32}`, true),
 
 		Keywords: []string{
 			"mailgun",
@@ -27,9 +28,10 @@ func MailGunPrivateAPIToken() *config.Rule {
 func MailGunPubAPIToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "mailgun-pub-key",
-		Description: "Discovered a Mailgun public validation key, which could expose email verification processes and associated data.",
-		Regex:       generateSemiGenericRegex([]string{"mailgun"}, `pubkey-[a-f0-9]{32}`, true),
+		RuleID:         "mailgun-pub-key",
+		Description:    "Discovered a Mailgun public validation key, which could expose email verification processes and associated data.",
+		RemediationURL: "https://app.mailgun.com/settings/api_security",
+		Regex:          generateSemiGenericRegex([]string{"mailgun"}, `pubkey-[a-f0-9]{32}`, true),
 
 		Keywords: []string{
 			"mailgun",
@@ -46,9 +48,10 @@ func MailGunPubAPIToken() *config.Rule {
 func MailGunSigningKey() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "mailgun-signing-key",
-		Description: "Uncovered a Mailgun webhook signing key, potentially compromising email automation and data integrity.",
-		Regex:       generateSemiGenericRegex([]string{"mailgun"}, `[a-h0-9]{32}-[a-h0-9]{8}-[a-h0-9]{8}`, true),
+		RuleID:         "mailgun-signing-key",
+		Description:    "Uncovered a Mailgun webhook signing key, potentially compromising email automation and data integrity.",
+		RemediationURL: "https://app.mailgun.com/settings/api_security",
+		Regex:          generateSemiGenericRegex([]string{"mailgun"}, `[a-h0-9]{32}-[a-h0-9]{8}-[a-h0-9]{8}`, true),
 
 		Keywords: []string{
 			"mailgun",
@@ -8,16 +8,28 @@ import (
 func MapBox() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Detected a MapBox API token, posing a risk to geospatial services and sensitive location data exposure.",
+		Description: "Detected a MapBox secret token, posing a risk to geospatial services and sensitive location data exposure.",
 		RuleID:      "mapbox-api-token",
-		Regex:       generateSemiGenericRegex([]string{"mapbox"}, `pk\.[a-z0-9]{60}\.[a-z0-9]{22}`, true),
+		// public pk. tokens are meant to be shipped to browsers, only
+		// secret sk. tokens are reported
+		Regex: generateSemiGenericRegex([]string{"mapbox"}, `sk\.`+alphaNumericExtendedShort("60,")+`\.`+alphaNumericExtendedShort("22"), true),
 
 		Keywords: []string{"mapbox"},
+		// sk. tokens of 86 or more [a-z0-9_.-] characters, the tps score
+		// 4.8-5.7
+		Entropy: 3,
+		Tags:    []string{"mapbox", "saas"},
 	}
 
 	// validate
 	tps := []string{
+		generateSampleSecret("mapbox", "sk."+secrets.NewSecret(alphaNumeric("60"))+"."+secrets.NewSecret(alphaNumeric("22"))),
+		"MAPBOX_DOWNLOADS_TOKEN=sk.eyJ1" + secrets.NewSecret(`[A-Za-z0-9_-]{80}`) + "." + secrets.NewSecret(`[A-Za-z0-9_-]{22}`),
+	}
+	fps := []string{
+		// public token
 		generateSampleSecret("mapbox", "pk."+secrets.NewSecret(alphaNumeric("60"))+"."+secrets.NewSecret(alphaNumeric("22"))),
+		generateSampleSecret("mapbox", "sk."+secrets.NewSecret(`x{60}`)+"."+secrets.NewSecret(`x{22}`)),
 	}
-	return validate(r, tps, nil)
+	return validate(r, tps, fps)
 }
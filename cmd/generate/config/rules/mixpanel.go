@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func MixpanelToken() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "mixpanel-token",
+		Description: "Detected a Mixpanel project token or API secret, which allows sending events to or exporting data from a Mixpanel project.",
+		Regex:       generateSemiGenericRegex([]string{"mixpanel"}, hex("32"), true),
+		Keywords:    []string{"mixpanel"},
+		// 32 hex characters, the tps score 3.6-3.9
+		Entropy: 2.5,
+		Tags:    []string{"mixpanel", "analytics"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("mixpanel", secrets.NewSecret(hex("32"))),
+		"MIXPANEL_API_SECRET=" + secrets.NewSecret(hex("32")),
+	}
+	fps := []string{
+		// md5 of a file, not near a mixpanel keyword
+		`md5 = "` + secrets.NewSecret(hex("32")) + `"`,
+		"MIXPANEL_TOKEN=" + secrets.NewSecret(`0{32}`),
+	}
+	return validate(r, tps, fps)
+}
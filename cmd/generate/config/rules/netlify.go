@@ -16,6 +16,7 @@ func NetlifyAccessToken() *config.Rule {
 		Keywords: []string{
 			"netlify",
 		},
+		Tags: []string{"netlify", "paas"},
 	}
 
 	// validate
@@ -24,3 +25,28 @@ func NetlifyAccessToken() *config.Rule {
 	}
 	return validate(r, tps, nil)
 }
+
+func NetlifyPAT() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "netlify-personal-access-token",
+		Description: "Detected a Netlify personal access token, which allows deploying and configuring the sites of a Netlify account.",
+		Regex:       generateUniqueTokenRegex(`nfp_`+alphaNumeric("36"), true),
+		Keywords:    []string{"nfp_"},
+		// skips placeholders such as nfp_xxx...
+		Entropy: 3,
+		Tags:    []string{"netlify", "paas"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("netlify", "nfp_"+secrets.NewSecret(`[A-Za-z0-9]{36}`)),
+		"NETLIFY_AUTH_TOKEN=nfp_" + secrets.NewSecret(`[A-Za-z0-9]{36}`),
+	}
+	fps := []string{
+		// truncated token
+		"NETLIFY_AUTH_TOKEN=nfp_" + secrets.NewSecret(`[A-Za-z0-9]{30}`),
+		"NETLIFY_AUTH_TOKEN=nfp_" + secrets.NewSecret(`x{36}`),
+	}
+	return validate(r, tps, fps)
+}
@@ -19,13 +19,22 @@ func NewRelicUserID() *config.Rule {
 		Keywords: []string{
 			"NRAK",
 		},
+		// skips placeholders such as NRAK-000...
+		Entropy: 3,
+		Tags:    []string{"newrelic", "observability"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("new-relic", "NRAK-"+secrets.NewSecret(alphaNumeric("27"))),
+		"NEW_RELIC_API_KEY=NRAK-" + secrets.NewSecret(`[A-Z0-9]{27}`),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated key
+		generateSampleSecret("new-relic", "NRAK-"+secrets.NewSecret(alphaNumeric("20"))),
+		generateSampleSecret("new-relic", "NRAK-"+secrets.NewSecret(`0{27}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func NewRelicUserKey() *config.Rule {
@@ -44,6 +53,7 @@ func NewRelicUserKey() *config.Rule {
 			"newrelic",
 			"new_relic",
 		},
+		Tags: []string{"newrelic", "observability"},
 	}
 
 	// validate
@@ -67,11 +77,54 @@ func NewRelicBrowserAPIKey() *config.Rule {
 		Keywords: []string{
 			"NRJS-",
 		},
+		// skips placeholders such as NRJS-000...
+		Entropy: 3,
+		Tags:    []string{"newrelic", "observability"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("new-relic", "NRJS-"+secrets.NewSecret(hex("19"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// not near a new relic keyword
+		generateSampleSecret("browser", "NRJS-"+secrets.NewSecret(hex("19"))),
+		generateSampleSecret("new-relic", "NRJS-"+secrets.NewSecret(`0{19}`)),
+	}
+	return validate(r, tps, fps)
+}
+
+func NewRelicLicenseKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "new-relic-license-key",
+		Description: "Detected a New Relic ingest license key, which allows reporting data to a New Relic account and reading its account id.",
+		Regex: generateSemiGenericRegex([]string{
+			"new-relic",
+			"newrelic",
+			"new_relic",
+		}, `[a-f0-9]{36}NRAL`, true),
+
+		Keywords: []string{
+			"NRAL",
+		},
+		// skips placeholders such as 000...NRAL
+		Entropy: 3,
+		Tags:    []string{"newrelic", "observability"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("new-relic", secrets.NewSecret(hex("36"))+"NRAL"),
+		"NEW_RELIC_LICENSE_KEY=" + secrets.NewSecret(hex("36")) + "NRAL",
+		`newrelic.license_key: "` + secrets.NewSecret(hex("36")) + `NRAL"`,
+	}
+	fps := []string{
+		// legacy 40 hex license key without the suffix
+		"NEW_RELIC_LICENSE_KEY=" + secrets.NewSecret(hex("40")),
+		// not near a new relic keyword
+		`license_key = "` + secrets.NewSecret(hex("36")) + `NRAL"`,
+		"NEW_RELIC_LICENSE_KEY=" + secrets.NewSecret(`0{36}`) + "NRAL",
+	}
+	return validate(r, tps, fps)
 }
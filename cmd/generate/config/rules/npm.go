@@ -8,9 +8,10 @@ import (
 func NPM() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "npm-access-token",
-		Description: "Uncovered an npm access token, potentially compromising package management and code repository access.",
-		Regex:       generateUniqueTokenRegex(`npm_[a-z0-9]{36}`, true),
+		RuleID:         "npm-access-token",
+		Description:    "Uncovered an npm access token, potentially compromising package management and code repository access.",
+		RemediationURL: "https://docs.npmjs.com/revoking-access-tokens",
+		Regex:          generateUniqueTokenRegex(`npm_[a-z0-9]{36}`, true),
 
 		Keywords: []string{
 			"npm_",
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"regexp"
+
 	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
@@ -10,17 +12,31 @@ func OktaAccessToken() *config.Rule {
 	r := config.Rule{
 		RuleID:      "okta-access-token",
 		Description: "Identified an Okta Access Token, which may compromise identity management services and user authentication data.",
-		Regex: generateSemiGenericRegex([]string{"okta"},
-			alphaNumericExtended("42"), true),
-
+		// SSWS api tokens are assigned to a variable or sent as
+		// `Authorization: SSWS <token>`
+		Regex: regexp.MustCompile(caseInsensitive + `(?:` + identifierPrefix + `okta` + identifierSuffix + operator +
+			`(?:'|\"|\s|=|\x60){0,5}|ssws\s+)(00` + alphaNumericExtendedShort("40") + secretSuffix),
 		Keywords: []string{
 			"okta",
+			"ssws",
 		},
+		// skips placeholders such as 00000...
+		Entropy: 3.5,
+		Tags:    []string{"okta", "identity"},
 	}
 
 	// validate
 	tps := []string{
-		generateSampleSecret("okta", secrets.NewSecret(alphaNumeric("42"))),
+		generateSampleSecret("okta", "00"+secrets.NewSecret(alphaNumericExtendedShort("40"))),
+		"Authorization: SSWS 00" + secrets.NewSecret(alphaNumericExtendedShort("40")),
+		"OKTA_API_TOKEN=00" + secrets.NewSecret(alphaNumeric("40")),
+	}
+	fps := []string{
+		// not an SSWS token
+		generateSampleSecret("okta", secrets.NewSecret(`[a-z1-9][a-z0-9]{41}`)),
+		// not near an okta keyword
+		`token = "00` + secrets.NewSecret(alphaNumeric("40")) + `"`,
+		generateSampleSecret("okta", "00"+secrets.NewSecret(`0{40}`)),
 	}
-	return validate(r, tps, nil)
+	return validate(r, tps, fps)
 }
@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func OpsgenieAPIKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "opsgenie-api-key",
+		Description: "Detected an Opsgenie API key, which allows creating, acknowledging and closing alerts of an Opsgenie account.",
+		// keys are UUIDs, so they are only reported when assigned near
+		// opsgenie or sent as `Authorization: GenieKey <key>`
+		Regex: regexp.MustCompile(caseInsensitive + `(?:` + identifierPrefix + `opsgenie` + identifierSuffix + operator +
+			`(?:'|\"|\s|=|\x60){0,5}|geniekey\s+)(` + hex8_4_4_4_12() + secretSuffix),
+		Keywords: []string{
+			"opsgenie",
+			"geniekey",
+		},
+		// skips placeholders such as 00000000-0000-...
+		Entropy: 3,
+		Tags:    []string{"opsgenie", "incident"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("opsgenie", secrets.NewSecret(hex8_4_4_4_12())),
+		"Authorization: GenieKey " + secrets.NewSecret(hex8_4_4_4_12()),
+		`OPSGENIE_API_KEY="` + secrets.NewSecret(hex8_4_4_4_12()) + `"`,
+	}
+	fps := []string{
+		// a uuid not near an opsgenie keyword
+		`request_id = "` + secrets.NewSecret(hex8_4_4_4_12()) + `"`,
+		"OPSGENIE_API_KEY=00000000-0000-0000-0000-000000000000",
+	}
+	return validate(r, tps, fps)
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func PagerDutyAPIKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "pagerduty-api-key",
+		Description: "Detected a PagerDuty API key, which allows reading and changing incidents, schedules and escalation policies of a PagerDuty account.",
+		// user and account api keys start with a type prefix such as u+,
+		// v2 api tokens are 20 characters
+		Regex: generateSemiGenericRegex([]string{"pagerduty", "pager_duty"},
+			`(?:[ruy]\+[a-z0-9_\-]{19}|[a-z0-9_+\-]{20})`, true),
+		Keywords: []string{
+			"pagerduty",
+			"pager_duty",
+		},
+		// 20 characters after an optional type prefix such as u+, the tps
+		// score 3.8-4.3, u+ followed by x's 0.55
+		Entropy: 3,
+		Tags:    []string{"pagerduty", "incident"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("pagerduty", "u+"+secrets.NewSecret(`[A-Za-z0-9_\-]{19}`)),
+		"PAGERDUTY_TOKEN=" + secrets.NewSecret(`[A-Za-z0-9_\-]{20}`),
+		`pager_duty_api_key: "y+` + secrets.NewSecret(`[A-Za-z0-9_\-]{19}`) + `"`,
+	}
+	fps := []string{
+		// not near a pagerduty keyword
+		`api_key = "u+` + secrets.NewSecret(`[A-Za-z0-9_\-]{19}`) + `"`,
+		// truncated key
+		"PAGERDUTY_TOKEN=" + secrets.NewSecret(`[A-Za-z0-9_\-]{12}`),
+		"PAGERDUTY_TOKEN=xxxxxxxxxxxxxxxxxxxx",
+	}
+	return validate(r, tps, fps)
+}
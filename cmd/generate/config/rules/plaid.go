@@ -14,17 +14,22 @@ func PlaidAccessID() *config.Rule {
 		Description: "Uncovered a Plaid Client ID, which could lead to unauthorized financial service integrations and data breaches.",
 		Regex:       generateSemiGenericRegex([]string{"plaid"}, alphaNumeric("24"), true),
 
-		Entropy: 3.5,
+		// random 24 character ids can score below 3.5
+		Entropy: 3,
 		Keywords: []string{
 			"plaid",
 		},
+		Tags: []string{"plaid", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("plaid", secrets.NewSecret(alphaNumeric("24"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("plaid", secrets.NewSecret(`x{24}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func PlaidSecretKey() *config.Rule {
@@ -38,13 +43,17 @@ func PlaidSecretKey() *config.Rule {
 		Keywords: []string{
 			"plaid",
 		},
+		Tags: []string{"plaid", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("plaid", secrets.NewSecret(alphaNumeric("30"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("plaid", secrets.NewSecret(`x{30}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func PlaidAccessToken() *config.Rule {
@@ -52,17 +61,32 @@ func PlaidAccessToken() *config.Rule {
 	r := config.Rule{
 		RuleID:      "plaid-api-token",
 		Description: "Discovered a Plaid API Token, potentially compromising financial data aggregation and banking services.",
-		Regex: generateSemiGenericRegex([]string{"plaid"},
+		// the environment prefix makes access tokens specific enough to be
+		// reported without a plaid keyword
+		Regex: generateUniqueTokenRegex(
 			fmt.Sprintf("access-(?:sandbox|development|production)-%s", hex8_4_4_4_12()), true),
 
 		Keywords: []string{
-			"plaid",
+			"access-sandbox-",
+			"access-development-",
+			"access-production-",
 		},
+		// skips placeholders such as access-sandbox-00000000-0000-...
+		Entropy: 3,
+		Tags:    []string{"plaid", "fintech"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("plaid", secrets.NewSecret(fmt.Sprintf("access-(?:sandbox|development|production)-%s", hex8_4_4_4_12()))),
+		`{"access_token": "access-production-` + secrets.NewSecret(hex8_4_4_4_12()) + `"}`,
+	}
+	fps := []string{
+		// sandbox placeholders from the plaid docs
+		`access_token = "access-sandbox-00000000-0000-0000-0000-000000000000"`,
+		`access_token = "access-sandbox-xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"`,
+		// public tokens are exchanged for access tokens and expire quickly
+		`public_token = "public-sandbox-` + secrets.NewSecret(hex8_4_4_4_12()) + `"`,
 	}
-	return validate(r, tps, nil)
+	return validate(r, tps, fps)
 }
@@ -15,11 +15,19 @@ func PostManAPI() *config.Rule {
 		Keywords: []string{
 			"PMAK-",
 		},
+		// skips placeholders such as PMAK-000...
+		Entropy: 3,
+		Tags:    []string{"postman", "api"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("postmanAPItoken", "PMAK-"+secrets.NewSecret(hex("24"))+"-"+secrets.NewSecret(hex("34"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// too short
+		generateSampleSecret("postmanAPItoken", "PMAK-"+secrets.NewSecret(hex("24"))+"-"+secrets.NewSecret(hex("30"))),
+		generateSampleSecret("postmanAPItoken", "PMAK-"+secrets.NewSecret(`0{24}`)+"-"+secrets.NewSecret(`0{34}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -10,8 +10,9 @@ import (
 func PyPiUploadToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Discovered a PyPI upload token, potentially compromising Python package distribution and repository integrity.",
-		RuleID:      "pypi-upload-token",
+		Description:    "Discovered a PyPI upload token, potentially compromising Python package distribution and repository integrity.",
+		RuleID:         "pypi-upload-token",
+		RemediationURL: "https://pypi.org/manage/account/token/",
 		Regex: regexp.MustCompile(
 			`pypi-AgEIcHlwaS5vcmc[A-Za-z0-9\-_]{50,1000}`),
 		Keywords: []string{
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func RollbarAccessToken() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "rollbar-access-token",
+		Description: "Detected a Rollbar access token, which depending on its scope allows posting or reading the error data of a Rollbar project.",
+		Regex:       generateSemiGenericRegex([]string{"rollbar", "post_server_item"}, hex("32"), true),
+		Keywords: []string{
+			"rollbar",
+			"post_server_item",
+		},
+		// 32 hex characters, the tps score 3.6-3.8. 2.5 like the other
+		// 32 character hex rules, such as datadog-api-key
+		Entropy: 2.5,
+		Tags:    []string{"rollbar", "observability"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("rollbar", secrets.NewSecret(hex("32"))),
+		"ROLLBAR_ACCESS_TOKEN=" + secrets.NewSecret(hex("32")),
+		`post_server_item: "` + secrets.NewSecret(hex("32")) + `"`,
+	}
+	fps := []string{
+		// md5 of a file, not near a rollbar keyword
+		`md5 = "` + secrets.NewSecret(hex("32")) + `"`,
+		"ROLLBAR_ACCESS_TOKEN=" + secrets.NewSecret(`0{32}`),
+	}
+	return validate(r, tps, fps)
+}
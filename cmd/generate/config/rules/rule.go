@@ -94,6 +94,29 @@ func validate(r config.Rule, truePositives []string, falsePositives []string) *c
 			log.Fatal().Msgf("Failed to validate. For rule ID [%s], false positive [%s] was detected by regexp [%s]", r.RuleID, fp, r.Regex)
 		}
 	}
+	// the true positives become the examples checked by --self-test
+	r.Examples = truePositives
+	return &r
+}
+
+// validatePath is like validate for rules that only match file paths. The
+// true and false positives are paths of files with non-blank content.
+func validatePath(r config.Rule, truePositives []string, falsePositives []string) *config.Rule {
+	rules := make(map[string]config.Rule)
+	rules[r.RuleID] = r
+	d := detect.NewDetector(config.Config{
+		Rules: rules,
+	})
+	for _, tp := range truePositives {
+		if len(d.Detect(detect.Fragment{Raw: "content", FilePath: tp})) != 1 {
+			log.Fatal().Msgf("Failed to validate. For rule ID [%s], true positive [%s] was not detected by path [%s]", r.RuleID, tp, r.Path)
+		}
+	}
+	for _, fp := range falsePositives {
+		if len(d.Detect(detect.Fragment{Raw: "content", FilePath: fp})) != 0 {
+			log.Fatal().Msgf("Failed to validate. For rule ID [%s], false positive [%s] was detected by path [%s]", r.RuleID, fp, r.Path)
+		}
+	}
 	return &r
 }
 
@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func SegmentWriteKey() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "segment-write-key",
+		Description: "Detected a Segment write key, which allows sending tracking events to a Segment source and polluting its analytics data.",
+		Regex:       generateSemiGenericRegex([]string{"segment"}, alphaNumeric("32"), true),
+		Keywords:    []string{"segment"},
+		// segment is a common word, so 3.5 is higher than for other 32
+		// alphanumeric keys, the tps score 4.4-4.5
+		Entropy: 3.5,
+		Tags:    []string{"segment", "analytics"},
+	}
+
+	// validate
+	tps := []string{
+		generateSampleSecret("segment", secrets.NewSecret(alphaNumeric("32"))),
+		"SEGMENT_WRITE_KEY=" + secrets.NewSecret(`[A-Za-z0-9]{32}`),
+	}
+	fps := []string{
+		// truncated key
+		"SEGMENT_WRITE_KEY=" + secrets.NewSecret(`[A-Za-z0-9]{24}`),
+		`segment_name = "aaaaaaaabbbbbbbbaaaaaaaabbbbbbbb"`,
+	}
+	return validate(r, tps, fps)
+}
@@ -8,9 +8,10 @@ import (
 func SendGridAPIToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		RuleID:      "sendgrid-api-token",
-		Description: "Detected a SendGrid API token, posing a risk of unauthorized email service operations and data exposure.",
-		Regex:       generateUniqueTokenRegex(`SG\.(?i)[a-z0-9=_\-\.]{66}`, true),
+		RuleID:         "sendgrid-api-token",
+		Description:    "Detected a SendGrid API token, posing a risk of unauthorized email service operations and data exposure.",
+		RemediationURL: "https://docs.sendgrid.com/ui/account-and-settings/api-keys",
+		Regex:          generateUniqueTokenRegex(`SG\.(?i)[a-z0-9=_\-\.]{66}`, true),
 
 		Keywords: []string{
 			"SG.",
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"regexp"
+
 	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
@@ -15,11 +17,46 @@ func SentryAccessToken() *config.Rule {
 		Keywords: []string{
 			"sentry",
 		},
+		// 64 hex characters, the tps score 3.9
+		Entropy: 3,
+		Tags:    []string{"sentry", "observability"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("sentry", secrets.NewSecret(hex("64"))),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("sentry", secrets.NewSecret(`0{64}`)),
+	}
+	return validate(r, tps, fps)
+}
+
+func SentryDSN() *config.Rule {
+	// define rule
+	r := config.Rule{
+		RuleID:      "sentry-dsn",
+		Description: "Found a Sentry DSN, whose key allows sending events to a Sentry project and can be abused to flood it or hide real errors.",
+		// the secret is the public key, the optional second key is the
+		// deprecated secret key
+		Regex:    regexp.MustCompile(`(?i)https://(` + hex("32") + `)(?::` + hex("32") + `)?@(?:[a-z0-9-]+\.)*sentry\.io/[0-9]+`),
+		Keywords: []string{"sentry.io"},
+		// skips placeholders such as 000...
+		Entropy: 3,
+		Tags:    []string{"sentry", "observability"},
+	}
+
+	// validate
+	tps := []string{
+		`SENTRY_DSN=https://` + secrets.NewSecret(hex("32")) + `@o123456.ingest.sentry.io/4504000000000000`,
+		`Sentry.init({ dsn: "https://` + secrets.NewSecret(hex("32")) + `@o42.ingest.us.sentry.io/17" });`,
+		`dsn = "https://` + secrets.NewSecret(hex("32")) + `:` + secrets.NewSecret(hex("32")) + `@sentry.io/1234"`,
+	}
+	fps := []string{
+		// self hosted or unrelated hosts
+		`SENTRY_DSN=https://` + secrets.NewSecret(hex("32")) + `@example.com/42`,
+		`SENTRY_DSN=https://` + secrets.NewSecret(`0{32}`) + `@o0.ingest.sentry.io/0`,
+		`docs: https://docs.sentry.io/platforms/go/`,
+	}
+	return validate(r, tps, fps)
 }
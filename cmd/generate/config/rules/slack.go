@@ -12,8 +12,9 @@ import (
 func SlackBotToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Identified a Slack Bot token, which may compromise bot integrations and communication channel security.",
-		RuleID:      "slack-bot-token",
+		Description:    "Identified a Slack Bot token, which may compromise bot integrations and communication channel security.",
+		RuleID:         "slack-bot-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
 		Regex: regexp.MustCompile(
 			`(xoxb-[0-9]{10,13}\-[0-9]{10,13}[a-zA-Z0-9-]*)`),
 		Keywords: []string{
@@ -43,8 +44,9 @@ func SlackBotToken() *config.Rule {
 func SlackUserToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a Slack User token, posing a risk of unauthorized user impersonation and data access within Slack workspaces.",
-		RuleID:      "slack-user-token",
+		Description:    "Found a Slack User token, posing a risk of unauthorized user impersonation and data access within Slack workspaces.",
+		RuleID:         "slack-user-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
 		// The last segment seems to be consistently 32 characters. I've made it 28-34 just in case.
 		Regex:    regexp.MustCompile(`(xox[pe](?:-[0-9]{10,13}){3}-[a-zA-Z0-9-]{28,34})`),
 		Keywords: []string{"xoxp-", "xoxe-"},
@@ -81,8 +83,9 @@ func SlackUserToken() *config.Rule {
 func SlackAppLevelToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Detected a Slack App-level token, risking unauthorized access to Slack applications and workspace data.",
-		RuleID:      "slack-app-token",
+		Description:    "Detected a Slack App-level token, risking unauthorized access to Slack applications and workspace data.",
+		RuleID:         "slack-app-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
 		// This regex is based on a limited number of examples and may not be 100% accurate.
 		Regex:    regexp.MustCompile(`(?i)(xapp-\d-[A-Z0-9]+-\d+-[a-z0-9]+)`),
 		Keywords: []string{"xapp"},
@@ -102,10 +105,11 @@ func SlackAppLevelToken() *config.Rule {
 func SlackConfigurationToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a Slack Configuration access token, posing a risk to workspace configuration and sensitive data access.",
-		RuleID:      "slack-config-access-token",
-		Regex:       regexp.MustCompile(`(?i)(xoxe.xox[bp]-\d-[A-Z0-9]{163,166})`),
-		Keywords:    []string{"xoxe.xoxb-", "xoxe.xoxp-"},
+		Description:    "Found a Slack Configuration access token, posing a risk to workspace configuration and sensitive data access.",
+		RuleID:         "slack-config-access-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
+		Regex:          regexp.MustCompile(`(?i)(xoxe.xox[bp]-\d-[A-Z0-9]{163,166})`),
+		Keywords:       []string{"xoxe.xoxb-", "xoxe.xoxp-"},
 	}
 
 	tps := []string{
@@ -126,10 +130,11 @@ func SlackConfigurationToken() *config.Rule {
 func SlackConfigurationRefreshToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Discovered a Slack Configuration refresh token, potentially allowing prolonged unauthorized access to configuration settings.",
-		RuleID:      "slack-config-refresh-token",
-		Regex:       regexp.MustCompile(`(?i)(xoxe-\d-[A-Z0-9]{146})`),
-		Keywords:    []string{"xoxe-"},
+		Description:    "Discovered a Slack Configuration refresh token, potentially allowing prolonged unauthorized access to configuration settings.",
+		RuleID:         "slack-config-refresh-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
+		Regex:          regexp.MustCompile(`(?i)(xoxe-\d-[A-Z0-9]{146})`),
+		Keywords:       []string{"xoxe-"},
 	}
 
 	tps := []string{
@@ -144,8 +149,9 @@ func SlackConfigurationRefreshToken() *config.Rule {
 // Reference: https://api.slack.com/authentication/token-types#legacy_bot
 func SlackLegacyBotToken() *config.Rule {
 	r := config.Rule{
-		Description: "Uncovered a Slack Legacy bot token, which could lead to compromised legacy bot operations and data exposure.",
-		RuleID:      "slack-legacy-bot-token",
+		Description:    "Uncovered a Slack Legacy bot token, which could lead to compromised legacy bot operations and data exposure.",
+		RuleID:         "slack-legacy-bot-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
 		// This rule is based off the limited information I could find and may not be 100% accurate.
 		Regex: regexp.MustCompile(
 			`(xoxb-[0-9]{8,14}\-[a-zA-Z0-9]{18,26})`),
@@ -187,8 +193,9 @@ func SlackLegacyBotToken() *config.Rule {
 // Reference: https://api.slack.com/authentication/token-types#workspace
 func SlackLegacyWorkspaceToken() *config.Rule {
 	r := config.Rule{
-		Description: "Identified a Slack Legacy Workspace token, potentially compromising access to workspace data and legacy features.",
-		RuleID:      "slack-legacy-workspace-token",
+		Description:    "Identified a Slack Legacy Workspace token, potentially compromising access to workspace data and legacy features.",
+		RuleID:         "slack-legacy-workspace-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
 		// This is by far the least confident pattern.
 		Regex: regexp.MustCompile(
 			`(xox[ar]-(?:\d-)?[0-9a-zA-Z]{8,48})`),
@@ -221,10 +228,11 @@ func SlackLegacyWorkspaceToken() *config.Rule {
 func SlackLegacyToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Detected a Slack Legacy token, risking unauthorized access to older Slack integrations and user data.",
-		RuleID:      "slack-legacy-token",
-		Regex:       regexp.MustCompile(`(xox[os]-\d+-\d+-\d+-[a-fA-F\d]+)`),
-		Keywords:    []string{"xoxo", "xoxs"},
+		Description:    "Detected a Slack Legacy token, risking unauthorized access to older Slack integrations and user data.",
+		RuleID:         "slack-legacy-token",
+		RemediationURL: "https://api.slack.com/authentication/token-types",
+		Regex:          regexp.MustCompile(`(xox[os]-\d+-\d+-\d+-[a-fA-F\d]+)`),
+		Keywords:       []string{"xoxo", "xoxs"},
 	}
 
 	// validate
@@ -252,8 +260,9 @@ func SlackLegacyToken() *config.Rule {
 func SlackWebHookUrl() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Discovered a Slack Webhook, which could lead to unauthorized message posting and data leakage in Slack channels.",
-		RuleID:      "slack-webhook-url",
+		Description:    "Discovered a Slack Webhook, which could lead to unauthorized message posting and data leakage in Slack channels.",
+		RuleID:         "slack-webhook-url",
+		RemediationURL: "https://api.slack.com/messaging/webhooks",
 		// If this generates too many false-positives we should define an allowlist (e.g., "xxxx", "00000").
 		Regex: regexp.MustCompile(
 			`(https?:\/\/)?hooks.slack.com\/(services|workflows)\/[A-Za-z0-9+\/]{43,46}`),
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
 
@@ -21,6 +22,10 @@ func Snyk() *config.Rule {
 
 		Regex:    generateSemiGenericRegex(keywords, hex8_4_4_4_12(), true),
 		Keywords: keywords,
+		// uuids, the tps score 3.7-3.8, 00000000-0000-... 0.5 and
+		// 12345678-1234-... 3.2
+		Entropy: 3,
+		Tags:    []string{"snyk", "devtools"},
 	}
 
 	// validate
@@ -34,6 +39,12 @@ func Snyk() *config.Rule {
 		`SNYK_API_KEY ?= "12345678-ABCD-ABCD-ABCD-1234567890AB"`,    // gitleaks:allow
 		`SNYK_API_TOKEN = "12345678-ABCD-ABCD-ABCD-1234567890AB"`,   // gitleaks:allow
 		`SNYK_OAUTH_TOKEN = "12345678-ABCD-ABCD-ABCD-1234567890AB"`, // gitleaks:allow
+		"SNYK_TOKEN=" + secrets.NewSecret(hex8_4_4_4_12()),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// organization ids are uuids too, but are not secret
+		"SNYK_ORG_ID=" + secrets.NewSecret(hex8_4_4_4_12()),
+		"SNYK_TOKEN=00000000-0000-0000-0000-000000000000",
+	}
+	return validate(r, tps, fps)
 }
@@ -12,6 +12,9 @@ func SquareAccessToken() *config.Rule {
 		Description: "Detected a Square Access Token, risking unauthorized payment processing and financial transaction exposure.",
 		Regex:       generateUniqueTokenRegex(`(EAAA|sq0atp-)[0-9A-Za-z\-_]{22,60}`, true),
 		Keywords:    []string{"sq0atp-", "EAAA"},
+		// skips placeholders such as sq0atp-xxx...
+		Entropy: 3,
+		Tags:    []string{"square", "payments"},
 	}
 
 	// validate
@@ -20,22 +23,35 @@ func SquareAccessToken() *config.Rule {
 		"ARG token=sq0atp-812erere3wewew45678901",                                    // gitleaks:allow
 		"ARG token=EAAAlsBxkkVgvmr7FasTFbM6VUGZ31EJ4jZKTJZySgElBDJ_wyafHuBFquFexY7E", // gitleaks:allow",
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated token
+		generateSampleSecret("square", secrets.NewSecret(`sq0atp-[0-9A-Za-z\-_]{21}`)),
+		generateSampleSecret("square", "sq0atp-xxxxxxxxxxxxxxxxxxxxxx"),
+	}
+	return validate(r, tps, fps)
 }
 
 func SquareSecret() *config.Rule {
 	// define rule
 	r := config.Rule{
 		RuleID:      "square-secret",
-		Description: "Square Secret",
-		Regex:       generateUniqueTokenRegex(`sq0csp-[0-9A-Za-z\\-_]{43}`, true),
+		Description: "Detected a Square OAuth application secret, risking unauthorized access to merchant accounts and payment data.",
+		Regex:       generateUniqueTokenRegex(`sq0csp-[0-9A-Za-z\-_]{43}`, true),
 		Keywords:    []string{"sq0csp-"},
+		// skips placeholders such as sq0csp-xxx...
+		Entropy: 3,
+		Tags:    []string{"square", "payments"},
 	}
 
 	// validate
 	tps := []string{
-		generateSampleSecret("square", secrets.NewSecret(`sq0csp-[0-9A-Za-z\\-_]{43}`)),
+		generateSampleSecret("square", secrets.NewSecret(`sq0csp-[0-9A-Za-z\-_]{43}`)),
 		`value: "sq0csp-0p9h7g6f4s3s3s3-4a3ardgwa6ADRDJDDKUFYDYDYDY"`, // gitleaks:allow
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// truncated secret
+		generateSampleSecret("square", secrets.NewSecret(`sq0csp-[0-9A-Za-z\-_]{40}`)),
+		generateSampleSecret("square", "sq0csp-xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+	}
+	return validate(r, tps, fps)
 }
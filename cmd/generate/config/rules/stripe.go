@@ -8,9 +8,10 @@ import (
 func StripeAccessToken() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a Stripe Access Token, posing a risk to payment processing services and sensitive financial data.",
-		RuleID:      "stripe-access-token",
-		Regex:       generateUniqueTokenRegex(`(sk|rk)_(test|live|prod)_[0-9a-z]{10,99}`, true),
+		Description:    "Found a Stripe Access Token, posing a risk to payment processing services and sensitive financial data.",
+		RuleID:         "stripe-access-token",
+		RemediationURL: "https://stripe.com/docs/keys",
+		Regex:          generateUniqueTokenRegex(`(sk|rk)_(test|live|prod)_[0-9a-z]{10,99}`, true),
 		Keywords: []string{
 			"sk_test",
 			"sk_live",
@@ -20,6 +20,7 @@ func SumoLogicAccessID() *config.Rule {
 		Keywords: []string{
 			"sumo",
 		},
+		Tags: []string{"sumologic", "observability"},
 		Allowlist: config.Allowlist{
 			RegexTarget: "line",
 			Regexes: []*regexp.Regexp{
@@ -34,7 +35,7 @@ func SumoLogicAccessID() *config.Rule {
 		`sumologic_access_id = "sug5XpdpaoxtOH"`,     // gitleaks:allow
 		`export SUMOLOGIC_ACCESSID="suDbJw97o9WVo0"`, // gitleaks:allow
 		`SUMO_ACCESS_ID = "suGyI5imvADdvU"`,          // gitleaks:allow
-		generateSampleSecret("sumo", "su"+secrets.NewSecret(alphaNumeric("12"))),
+		generateSampleSecret("sumo", "su"+secrets.NewSecret(`[a-zA-Z0-9]{12}`)),
 	}
 	fps := []string{
 		`- (NSNumber *)sumOfProperty:(NSString *)property;`,
@@ -62,6 +63,7 @@ func SumoLogicAccessToken() *config.Rule {
 		Keywords: []string{
 			"sumo",
 		},
+		Tags: []string{"sumologic", "observability"},
 	}
 
 	// validate
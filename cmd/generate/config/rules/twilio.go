@@ -10,10 +10,11 @@ import (
 func Twilio() *config.Rule {
 	// define rule
 	r := config.Rule{
-		Description: "Found a Twilio API Key, posing a risk to communication services and sensitive customer interaction data.",
-		RuleID:      "twilio-api-key",
-		Regex:       regexp.MustCompile(`SK[0-9a-fA-F]{32}`),
-		Keywords:    []string{"twilio"},
+		Description:    "Found a Twilio API Key, posing a risk to communication services and sensitive customer interaction data.",
+		RuleID:         "twilio-api-key",
+		RemediationURL: "https://www.twilio.com/docs/iam/api-keys",
+		Regex:          regexp.MustCompile(`SK[0-9a-fA-F]{32}`),
+		Keywords:       []string{"twilio"},
 	}
 
 	// validate
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"regexp"
+
 	"github.com/zricethezav/gitleaks/v8/cmd/generate/secrets"
 	"github.com/zricethezav/gitleaks/v8/config"
 )
@@ -42,16 +44,29 @@ func TwitterBearerToken() *config.Rule {
 	r := config.Rule{
 		Description: "Discovered a Twitter Bearer Token, potentially compromising API access and data retrieval from Twitter.",
 		RuleID:      "twitter-bearer-token",
-		Regex:       generateSemiGenericRegex([]string{"twitter"}, "A{22}[a-zA-Z0-9%]{80,100}", true),
+		// assigned near twitter or sent as `Authorization: Bearer <token>`,
+		// app only bearer tokens start with 22 A's
+		Regex: regexp.MustCompile(caseInsensitive + `(?:` + identifierPrefix + `twitter` + identifierSuffix + operator +
+			`(?:'|\"|\s|=|\x60){0,5}|bearer\s+)(A{22}[a-z0-9%]{80,100}` + secretSuffix),
 
-		Keywords: []string{"twitter"},
+		Keywords: []string{"twitter", "bearer"},
+		// 22 A's and 80 or more characters, the A's lower the entropy,
+		// the tps still score 4.8-4.9 and 22 A's followed by x's 0.75
+		Entropy: 3.5,
+		Tags:    []string{"twitter", "social"},
 	}
 
 	// validate
 	tps := []string{
 		generateSampleSecret("twitter", secrets.NewSecret("A{22}[a-zA-Z0-9%]{80,100}")),
+		"curl -H \"Authorization: Bearer " + secrets.NewSecret("A{22}[a-zA-Z0-9%]{80,100}") + "\" https://api.x.com/2/tweets",
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		// not an app only bearer token
+		"Authorization: Bearer " + secrets.NewSecret("[a-zA-Z0-9]{100}"),
+		generateSampleSecret("twitter", secrets.NewSecret("A{102}")),
+	}
+	return validate(r, tps, fps)
 }
 
 func TwitterAccessToken() *config.Rule {
@@ -15,6 +15,10 @@ func YandexAWSAccessToken() *config.Rule {
 		Keywords: []string{
 			"yandex",
 		},
+		// YC and 38 characters, the tps score 4.8 and YC followed by
+		// x's 0.3
+		Entropy: 3.5,
+		Tags:    []string{"yandex", "cloud"},
 	}
 
 	// validate
@@ -22,7 +26,12 @@ func YandexAWSAccessToken() *config.Rule {
 		generateSampleSecret("yandex",
 			secrets.NewSecret(`YC[a-zA-Z0-9_\-]{38}`)),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("yandex", "YC"+secrets.NewSecret(`x{38}`)),
+		// a key id, not the secret
+		generateSampleSecret("yandex", "YCAJ"+secrets.NewSecret(`[a-zA-Z0-9_\-]{21}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func YandexAPIKey() *config.Rule {
@@ -36,6 +45,9 @@ func YandexAPIKey() *config.Rule {
 		Keywords: []string{
 			"yandex",
 		},
+		// AQVN and 35 to 38 characters, the tps score 4.8
+		Entropy: 3.5,
+		Tags:    []string{"yandex", "cloud"},
 	}
 
 	// validate
@@ -43,7 +55,10 @@ func YandexAPIKey() *config.Rule {
 		generateSampleSecret("yandex",
 			secrets.NewSecret(`AQVN[A-Za-z0-9_\-]{35,38}`)),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("yandex", "AQVN"+secrets.NewSecret(`x{36}`)),
+	}
+	return validate(r, tps, fps)
 }
 
 func YandexAccessToken() *config.Rule {
@@ -57,6 +72,9 @@ func YandexAccessToken() *config.Rule {
 		Keywords: []string{
 			"yandex",
 		},
+		// t1. tokens of 90 or more characters, the tps score 5.5
+		Entropy: 3.5,
+		Tags:    []string{"yandex", "cloud"},
 	}
 
 	// validate
@@ -64,5 +82,8 @@ func YandexAccessToken() *config.Rule {
 		generateSampleSecret("yandex",
 			secrets.NewSecret(`t1\.[A-Z0-9a-z_-]+[=]{0,2}\.[A-Z0-9a-z_-]{86}[=]{0,2}`)),
 	}
-	return validate(r, tps, nil)
+	fps := []string{
+		generateSampleSecret("yandex", "t1.xxxxxxxxxx."+secrets.NewSecret(`x{86}`)),
+	}
+	return validate(r, tps, fps)
 }
@@ -3,13 +3,21 @@
 package secrets
 
 import (
+	"hash/fnv"
+
 	"github.com/lucasjones/reggen"
 )
 
+// NewSecret returns a string matching regex. The secrets end up in the
+// examples of the generated config, so the generator is seeded with the
+// regex and the config only changes where a regex does.
 func NewSecret(regex string) string {
 	g, err := reggen.NewGenerator(regex)
 	if err != nil {
 		panic(err)
 	}
+	h := fnv.New64a()
+	h.Write([]byte(regex))
+	g.SetSeed(int64(h.Sum64()))
 	return g.Generate(1)
 }
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/fatih/semgroup"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -15,6 +18,7 @@ import (
 	"github.com/zricethezav/gitleaks/v8/config"
 	"github.com/zricethezav/gitleaks/v8/detect"
 	"github.com/zricethezav/gitleaks/v8/report"
+	"github.com/zricethezav/gitleaks/v8/verify"
 )
 
 const banner = `
@@ -42,22 +46,44 @@ func init() {
 	cobra.OnInitialize(initLog)
 	rootCmd.PersistentFlags().StringP("config", "c", "", configDescription)
 	rootCmd.PersistentFlags().Int("exit-code", 1, "exit code when leaks have been encountered")
+	rootCmd.PersistentFlags().String("fail-on-severity", "", "only exit with --exit-code when a leak is at or above this severity (low, medium, high, critical), leaks from rules without a severity always count")
 	rootCmd.PersistentFlags().StringP("source", "s", ".", "path to source")
-	rootCmd.PersistentFlags().StringP("report-path", "r", "", "report file")
-	rootCmd.PersistentFlags().StringP("report-format", "f", "json", "output format (json, csv, junit, sarif)")
+	rootCmd.PersistentFlags().StringP("report-path", "r", "", "report file, - for stdout")
+	rootCmd.PersistentFlags().StringArray("report", nil, "write an additional report as format:path, can be repeated, ex: `--report=sarif:results.sarif --report=json:report.json`")
+	rootCmd.PersistentFlags().StringP("report-format", "f", "json", "output format (json, csv, junit, sarif, pretty)")
+	rootCmd.PersistentFlags().String("group-by", "", "organize report findings into sections, currently only \"tag\" is supported (json report format only)")
 	rootCmd.PersistentFlags().StringP("baseline-path", "b", "", "path to baseline with issues that can be ignored")
 	rootCmd.PersistentFlags().StringP("log-level", "l", "info", "log level (trace, debug, info, warn, error, fatal)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "show verbose output from scan")
+	rootCmd.PersistentFlags().Bool("dry-run", false, "scan without reporting findings, print only the number of findings per rule and per file as json")
+	rootCmd.PersistentFlags().Bool("summary", false, "print a json summary of the scan as the last line of stdout, also when no leaks are found")
 	rootCmd.PersistentFlags().BoolP("no-color", "", false, "turn off color for verbose output")
+	rootCmd.PersistentFlags().Int("threads", 40, "number of files or commits scanned in parallel")
 	rootCmd.PersistentFlags().Int("max-target-megabytes", 0, "files larger than this will be skipped")
+	rootCmd.PersistentFlags().Int("max-scan-megabytes", 0, "stop the scan once this much content has been scanned, 0 means unlimited")
+	rootCmd.PersistentFlags().Duration("timeout", 0, "stop the scan after this long, report the findings so far and exit with 1, ex: `--timeout=10m`, 0 means no timeout")
+	rootCmd.PersistentFlags().Int("max-line-bytes", 1_000_000, "longest line accepted when scanning from a pipe")
 	rootCmd.PersistentFlags().BoolP("ignore-gitleaks-allow", "", false, "ignore gitleaks:allow comments")
+	rootCmd.PersistentFlags().Int("max-commit-message-length", 0, "truncate commit messages in findings to this many characters, 0 keeps the full message")
 	rootCmd.PersistentFlags().Uint("redact", 0, "redact secrets from logs and stdout. To redact only parts of the secret just apply a percent value from 0..100. For example --redact=20 (default 100%)")
 	rootCmd.Flag("redact").NoOptDefVal = "100"
 	rootCmd.PersistentFlags().Bool("no-banner", false, "suppress banner")
+	rootCmd.PersistentFlags().Bool("self-test", false, "check every rule with examples against them and exit, without scanning")
+	rootCmd.PersistentFlags().Bool("strict-allowlists", false, "fail when an allowlist regex or path matches everything, instead of warning")
 	rootCmd.PersistentFlags().String("log-opts", "", "git log options")
 	rootCmd.PersistentFlags().StringSlice("enable-rule", []string{}, "only enable specific rules by id, ex: `gitleaks detect --enable-rule=atlassian-api-token --enable-rule=slack-access-token`")
+	rootCmd.PersistentFlags().StringSlice("exclude-rule", []string{}, "disable specific rules by id, takes precedence over --enable-rule, ex: `gitleaks detect --exclude-rule=generic-api-key`")
 	rootCmd.PersistentFlags().StringP("gitleaks-ignore-path", "i", ".", "path to .gitleaksignore file or folder containing one")
+	rootCmd.PersistentFlags().Bool("generate-ignore", false, "append the fingerprints of all findings to the --gitleaks-ignore-path file, or (--source)/.gitleaksignore if it is not set, and exit successfully")
 	rootCmd.PersistentFlags().Bool("follow-symlinks", false, "scan files that are symlinks to other files")
+	rootCmd.PersistentFlags().Float64("min-confidence", 0, "only report findings with at least this confidence, from 0 to 1. Findings of path only rules are always reported")
+	rootCmd.PersistentFlags().Int("max-matches-per-rule", 0, "only report the first N findings of each rule, 0 means unlimited")
+	rootCmd.PersistentFlags().Bool("skip-generated", false, "skip lockfiles and minified files, such as package-lock.json and *.min.js or files with very long lines")
+	rootCmd.PersistentFlags().Bool("decode-base64", false, "also scan the decoded content of base64 encoded strings")
+	rootCmd.PersistentFlags().Bool("join-concatenation", false, "also scan string literal concatenations in source files, such as \"AKIA\" + \"...\", as a single string")
+	rootCmd.PersistentFlags().Bool("log-entropy", false, "print the rule id, entropy and secret of every match to stderr, including matches skipped for low entropy, to help tune rule entropy")
+	rootCmd.PersistentFlags().Bool("verify", false, "check whether secrets are live credentials with supported providers, this makes network calls")
+	rootCmd.PersistentFlags().Bool("check-active", false, "mark findings from git history whose secret is still present in the file at HEAD")
 	err := viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 	if err != nil {
 		log.Fatal().Msgf("err binding config %s", err.Error())
@@ -102,10 +128,12 @@ func initConfig() {
 	}
 	if cfgPath != "" {
 		viper.SetConfigFile(cfgPath)
+		viper.SetConfigType(config.Format(cfgPath))
 		log.Debug().Msgf("using gitleaks config %s from `--config`", cfgPath)
 	} else if os.Getenv("GITLEAKS_CONFIG") != "" {
 		envPath := os.Getenv("GITLEAKS_CONFIG")
 		viper.SetConfigFile(envPath)
+		viper.SetConfigType(config.Format(envPath))
 		log.Debug().Msgf("using gitleaks config from GITLEAKS_CONFIG env var: %s", envPath)
 	} else {
 		source, err := rootCmd.Flags().GetString("source")
@@ -166,11 +194,68 @@ func Config(cmd *cobra.Command) config.Config {
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to load config")
 	}
+	if repoConfigPath := repoConfigPath(cmd); repoConfigPath != "" {
+		repoConfig, err := config.Load(repoConfigPath)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("Failed to load repository config %s", repoConfigPath)
+		}
+		log.Info().Msgf("merging repository config %s into global config", repoConfigPath)
+		if err := cfg.Merge(repoConfig); err != nil {
+			log.Fatal().Err(err).Msgf("Failed to merge repository config %s", repoConfigPath)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatal().Msgf("invalid config:\n%s", err)
+	}
+	if selfTest, _ := cmd.Flags().GetBool("self-test"); selfTest {
+		os.Exit(runSelfTest(cfg))
+	}
+	if warnings := config.WarnBroadAllowlists(cfg); len(warnings) > 0 {
+		strict, _ := cmd.Flags().GetBool("strict-allowlists")
+		for _, warning := range warnings {
+			if strict {
+				log.Error().Msg(warning)
+			} else {
+				log.Warn().Msg(warning)
+			}
+		}
+		if strict {
+			log.Fatal().Msg("invalid config: allowlists match everything, see --strict-allowlists")
+		}
+	}
 	cfg.Path, _ = cmd.Flags().GetString("config")
 
 	return cfg
 }
 
+// repoConfigPath returns the path of the `.gitleaks.toml` at the root of the
+// scanned source if it should be merged into the global config. This is the
+// case when the global config is set with --config or GITLEAKS_CONFIG,
+// otherwise the repository config is already the config in use.
+func repoConfigPath(cmd *cobra.Command) string {
+	globalPath, _ := cmd.Flags().GetString("config")
+	if globalPath == "" {
+		globalPath = os.Getenv("GITLEAKS_CONFIG")
+	}
+	if globalPath == "" {
+		return ""
+	}
+	source, _ := cmd.Flags().GetString("source")
+	repoPath := filepath.Join(source, ".gitleaks.toml")
+	if !fileExists(repoPath) {
+		return ""
+	}
+	globalAbs, err := filepath.Abs(globalPath)
+	if err != nil {
+		return ""
+	}
+	repoAbs, err := filepath.Abs(repoPath)
+	if err != nil || repoAbs == globalAbs {
+		return ""
+	}
+	return repoPath
+}
+
 func Detector(cmd *cobra.Command, cfg config.Config, source string) *detect.Detector {
 	var err error
 
@@ -201,13 +286,76 @@ func Detector(cmd *cobra.Command, cfg config.Config, source string) *detect.Dete
 	if detector.Verbose, err = cmd.Flags().GetBool("verbose"); err != nil {
 		log.Fatal().Err(err).Msg("")
 	}
+	// a dry run must not print findings
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun && detector.Verbose {
+		log.Warn().Msg("--verbose is ignored with --dry-run")
+		detector.Verbose = false
+	}
 	// set redact flag
 	if detector.Redact, err = cmd.Flags().GetUint("redact"); err != nil {
 		log.Fatal().Err(err).Msg("")
 	}
+	if threads, err := cmd.Flags().GetInt("threads"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	} else if threads < 1 {
+		log.Fatal().Msgf("--threads must be at least 1, got %d", threads)
+	} else {
+		detector.Sema = semgroup.NewGroup(context.Background(), int64(threads))
+	}
 	if detector.MaxTargetMegaBytes, err = cmd.Flags().GetInt("max-target-megabytes"); err != nil {
 		log.Fatal().Err(err).Msg("")
 	}
+	if maxScanMegaBytes, err := cmd.Flags().GetInt("max-scan-megabytes"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	} else {
+		detector.MaxScanBytes = int64(maxScanMegaBytes) * 1000000
+	}
+	if timeout, err := cmd.Flags().GetDuration("timeout"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	} else if timeout < 0 {
+		log.Fatal().Msgf("--timeout must not be negative, got %s", timeout)
+	} else if timeout > 0 {
+		detector.Deadline = time.Now().Add(timeout)
+	}
+	if detector.MaxLineBytes, err = cmd.Flags().GetInt("max-line-bytes"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.MaxCommitMessageLength, err = cmd.Flags().GetInt("max-commit-message-length"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.MaxMatchesPerRule, err = cmd.Flags().GetInt("max-matches-per-rule"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.MinConfidence, err = cmd.Flags().GetFloat64("min-confidence"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.MinConfidence < 0 || detector.MinConfidence > 1 {
+		log.Fatal().Msgf("--min-confidence %v must be between 0 and 1", detector.MinConfidence)
+	}
+	// validate reports before the scan, they are written after it
+	reports, _ := cmd.Flags().GetStringArray("report")
+	for _, r := range reports {
+		if _, err := report.ParseReportSpec(r); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+	}
+	if failOnSeverity, _ := cmd.Flags().GetString("fail-on-severity"); failOnSeverity != "" && config.SeverityRank(failOnSeverity) == -1 {
+		log.Fatal().Msgf("unknown --fail-on-severity %q, must be one of %s", failOnSeverity, strings.Join(config.Severities, ", "))
+	}
+	if detector.SkipGenerated, err = cmd.Flags().GetBool("skip-generated"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.DecodeBase64, err = cmd.Flags().GetBool("decode-base64"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if detector.JoinConcatenation, err = cmd.Flags().GetBool("join-concatenation"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if logEntropy, err := cmd.Flags().GetBool("log-entropy"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	} else if logEntropy {
+		detector.EntropyLog = os.Stderr
+	}
 	// set ignore gitleaks:allow flag
 	if detector.IgnoreGitleaksAllow, err = cmd.Flags().GetBool("ignore-gitleaks-allow"); err != nil {
 		log.Fatal().Err(err).Msg("")
@@ -245,19 +393,33 @@ func Detector(cmd *cobra.Command, cfg config.Config, source string) *detect.Dete
 		}
 	}
 
-	// If set, only apply rules that are defined in the flag
-	rules, _ := cmd.Flags().GetStringSlice("enable-rule")
-	if len(rules) > 0 {
-		log.Info().Msg("Overriding enabled rules: " + strings.Join(rules, ", "))
-		ruleOverride := make(map[string]config.Rule)
-		for _, ruleName := range rules {
-			if rule, ok := cfg.Rules[ruleName]; ok {
-				ruleOverride[ruleName] = rule
-			} else {
-				log.Fatal().Msgf("Requested rule %s not found in rules", ruleName)
-			}
-		}
-		detector.Config.Rules = ruleOverride
+	// If set, only apply rules that are defined in the flags
+	enableRules, _ := cmd.Flags().GetStringSlice("enable-rule")
+	excludeRules, _ := cmd.Flags().GetStringSlice("exclude-rule")
+	if len(enableRules) > 0 {
+		log.Info().Msg("Overriding enabled rules: " + strings.Join(enableRules, ", "))
+	}
+	if len(excludeRules) > 0 {
+		log.Info().Msg("Excluding rules: " + strings.Join(excludeRules, ", "))
+	}
+	for _, ruleID := range detector.Config.FilterRulesByID(enableRules, excludeRules) {
+		log.Warn().Msgf("Requested rule %s not found in rules", ruleID)
+	}
+	// a typo in every enabled id would otherwise report a clean scan
+	if len(detector.Config.Rules) == 0 && (len(enableRules) > 0 || len(excludeRules) > 0) {
+		log.Fatal().Msg("no rules left to run after --enable-rule and --exclude-rule")
+	}
+
+	if detector.CheckActive, err = cmd.Flags().GetBool("check-active"); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+
+	verifyFindings, err := cmd.Flags().GetBool("verify")
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if verifyFindings {
+		detector.Verifier = verify.Default(nil)
 	}
 
 	// set follow symlinks flag
@@ -267,8 +429,100 @@ func Detector(cmd *cobra.Command, cfg config.Config, source string) *detect.Dete
 	return detector
 }
 
-func findingSummaryAndExit(findings []report.Finding, cmd *cobra.Command, cfg config.Config, exitCode int, start time.Time, err error) {
-	if err == nil {
+// warnTruncatedRules logs a single notice for each rule whose findings were
+// capped by --max-matches-per-rule.
+func warnTruncatedRules(detector *detect.Detector) {
+	truncated := detector.TruncatedRules()
+	ruleIDs := make([]string, 0, len(truncated))
+	for ruleID := range truncated {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+	for _, ruleID := range ruleIDs {
+		log.Warn().Msgf("rule %s: %d findings truncated to the first %d",
+			ruleID, truncated[ruleID], detector.MaxMatchesPerRule)
+	}
+}
+
+// runSelfTest logs the rules that do not match any of their examples and
+// returns the exit code, 1 if any rule failed.
+func runSelfTest(cfg config.Config) int {
+	results := cfg.SelfTest()
+	failed := 0
+	for _, result := range results {
+		if !result.Passed() {
+			failed++
+			log.Error().Msgf("rule %s matched none of its %d examples", result.RuleID, result.Examples)
+		}
+	}
+	if failed > 0 {
+		log.Error().Msgf("self test failed: %d of %d rules with examples", failed, len(results))
+		return 1
+	}
+	log.Info().Msgf("self test passed: %d rules with examples", len(results))
+	return 0
+}
+
+// printSummary writes a json summary of the scan as the last line of stdout
+// if --summary is set.
+func printSummary(cmd *cobra.Command, detector *detect.Detector, findings []report.Finding, start time.Time, exitCode int, exitReason string) {
+	if summary, _ := cmd.Flags().GetBool("summary"); !summary {
+		return
+	}
+	s := report.NewSummary(findings, detector.Metrics(), start, exitCode, exitReason)
+	s.Errors = detector.ScanErrors()
+	if err := report.WriteSummary(os.Stdout, s); err != nil {
+		log.Error().Err(err).Msg("could not write summary")
+	}
+}
+
+// printDryRun prints the number of findings per rule and per file. No
+// reports are written and the exit code is 0 unless the scan failed or
+// timed out.
+func printDryRun(detector *detect.Detector, findings []report.Finding, start time.Time, err error) {
+	exitCode, exitReason := 0, report.ExitReasonNoLeaks
+	if err != nil {
+		exitCode, exitReason = 1, report.ExitReasonError
+	} else if detector.TimedOut() {
+		exitCode, exitReason = 1, report.ExitReasonTimeout
+	} else if len(findings) != 0 {
+		exitReason = report.ExitReasonLeaks
+	}
+	s := report.NewSummary(findings, detector.Metrics(), start, exitCode, exitReason)
+	s.Errors = detector.ScanErrors()
+	if err := report.WriteDryRun(os.Stdout, report.NewDryRun(findings, s)); err != nil {
+		log.Error().Err(err).Msg("could not write dry run")
+	}
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// logMetrics logs how much content was scanned and the throughput of the
+// scan.
+func logMetrics(detector *detect.Detector, start time.Time) {
+	metrics := detector.Metrics()
+	elapsed := time.Since(start)
+	var bytesPerSecond float64
+	if elapsed > 0 {
+		bytesPerSecond = float64(metrics.ScannedBytes) / elapsed.Seconds()
+	}
+	log.Info().Msgf("scanned %d commits, %d files and %d bytes (%.0f bytes/s), %d rule evaluations",
+		metrics.ScannedCommits, metrics.ScannedFiles, metrics.ScannedBytes, bytesPerSecond, metrics.RulesEvaluated)
+}
+
+func findingSummaryAndExit(detector *detect.Detector, findings []report.Finding, cmd *cobra.Command, cfg config.Config, exitCode int, start time.Time, err error) {
+	warnTruncatedRules(detector)
+	if detector.ScanBudgetExceeded() {
+		log.Warn().Msgf("scan budget of %d bytes exceeded, the remaining content was not scanned", detector.MaxScanBytes)
+	}
+	timedOut := detector.TimedOut()
+	if timedOut {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		log.Error().Msgf("scan timed out after %s, the remaining content was not scanned", timeout)
+	}
+	logMetrics(detector, start)
+	if err == nil && !timedOut {
 		log.Info().Msgf("scan completed in %s", FormatDuration(time.Since(start)))
 		if len(findings) != 0 {
 			log.Warn().Msgf("leaks found: %d", len(findings))
@@ -284,23 +538,92 @@ func findingSummaryAndExit(findings []report.Finding, cmd *cobra.Command, cfg co
 		}
 	}
 
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		printDryRun(detector, findings, start, err)
+		return
+	}
+
 	// write report if desired
 	reportPath, _ := cmd.Flags().GetString("report-path")
 	ext, _ := cmd.Flags().GetString("report-format")
+	groupBy, _ := cmd.Flags().GetString("group-by")
 	if reportPath != "" {
-		if err := report.Write(findings, cfg, ext, reportPath); err != nil {
-			log.Fatal().Err(err).Msg("could not write")
+		switch groupBy {
+		case "":
+			if err := report.Write(findings, cfg, ext, reportPath); err != nil {
+				log.Fatal().Err(err).Msg("could not write")
+			}
+		case "tag":
+			if err := report.WriteGroupedByTag(findings, ext, reportPath); err != nil {
+				log.Fatal().Err(err).Msg("could not write")
+			}
+		default:
+			log.Fatal().Msgf("unknown --group-by value %s", groupBy)
+		}
+	}
+	reports, _ := cmd.Flags().GetStringArray("report")
+	if len(reports) > 0 {
+		var specs []report.ReportSpec
+		for _, r := range reports {
+			// validated before the scan
+			spec, _ := report.ParseReportSpec(r)
+			specs = append(specs, spec)
+		}
+		if err := report.WriteReports(findings, cfg, specs); err != nil {
+			log.Error().Err(err).Msg("")
 		}
 	}
 
 	if err != nil {
+		printSummary(cmd, detector, findings, start, 1, report.ExitReasonError)
 		os.Exit(1)
 	}
+	if timedOut {
+		printSummary(cmd, detector, findings, start, 1, report.ExitReasonTimeout)
+		os.Exit(1)
+	}
+
+	// accept the current findings by adding them to the .gitleaksignore file
+	if generateIgnore, _ := cmd.Flags().GetBool("generate-ignore"); generateIgnore {
+		ignorePath := generatedIgnorePath(cmd)
+		if err := detect.WriteGitleaksIgnore(findings, ignorePath); err != nil {
+			log.Fatal().Err(err).Msg("could not write .gitleaksignore")
+		}
+		log.Info().Msgf("wrote fingerprints of %d findings to %s", len(findings), ignorePath)
+		printSummary(cmd, detector, findings, start, 0, report.ExitReasonLeaks)
+		return
+	}
 
 	if len(findings) != 0 {
+		failOnSeverity, _ := cmd.Flags().GetString("fail-on-severity")
+		if !report.MeetsSeverity(findings, failOnSeverity) {
+			log.Info().Msgf("no leaks at or above severity %s", failOnSeverity)
+			printSummary(cmd, detector, findings, start, 0, report.ExitReasonBelowSeverity)
+			return
+		}
+		printSummary(cmd, detector, findings, start, exitCode, report.ExitReasonLeaks)
 		os.Exit(exitCode)
 	}
+	printSummary(cmd, detector, findings, start, 0, report.ExitReasonNoLeaks)
+
+}
 
+// generatedIgnorePath returns the .gitleaksignore file --generate-ignore
+// writes to: the file --gitleaks-ignore-path points to, the .gitleaksignore
+// in the folder it points to, or without the flag the one in --source.
+func generatedIgnorePath(cmd *cobra.Command) string {
+	if cmd.Flags().Changed("gitleaks-ignore-path") {
+		ignorePath, _ := cmd.Flags().GetString("gitleaks-ignore-path")
+		if info, err := os.Stat(ignorePath); err == nil && info.IsDir() {
+			return filepath.Join(ignorePath, ".gitleaksignore")
+		}
+		return ignorePath
+	}
+	ignoreDir, _ := cmd.Flags().GetString("source")
+	if info, err := os.Stat(ignoreDir); err == nil && !info.IsDir() {
+		ignoreDir = filepath.Dir(ignoreDir)
+	}
+	return filepath.Join(ignoreDir, ".gitleaksignore")
 }
 
 func fileExists(fileName string) bool {
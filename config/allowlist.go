@@ -1,6 +1,9 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -23,6 +26,10 @@ type Allowlist struct {
 	// If RegexTarget is empty, it will be tested against the found secret.
 	RegexTarget string
 
+	// Condition is how Regexes are combined. With "OR", the default, any
+	// matching regex allows the target. With "AND" every regex must match.
+	Condition string
+
 	// Paths is a slice of path regular expressions that are allowed to be ignored.
 	Paths []*regexp.Regexp
 
@@ -33,6 +40,84 @@ type Allowlist struct {
 	// This targets the _secret_, not the content of the regex match like the
 	// Regexes slice.
 	StopWords []string
+
+	// Findings is a slice of single known findings that are allowed to be
+	// ignored, without ignoring the commit or path everywhere else.
+	Findings []AllowlistFinding
+
+	// LineHashes is a slice of LineHash values of lines that are allowed to
+	// be ignored. Unlike a path or regex, a hash only ignores the exact
+	// line, so any change to the line is reported again.
+	LineHashes []string
+
+	// Authors is a slice of names or emails of commit authors, such as bots,
+	// whose commits are allowed to be ignored in git scans.
+	Authors []string
+}
+
+// LineHash returns the hex encoded sha256 hash of a line without leading and
+// trailing whitespace, for use in Allowlist.LineHashes.
+func LineHash(line string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(line)))
+	return hex.EncodeToString(sum[:])
+}
+
+// AllowlistFinding allows a finding by the commit it was found in, the
+// path of the file and the secret. Every field that is set must match, and
+// at least two are set, so an entry cannot ignore a whole commit or path.
+type AllowlistFinding struct {
+	// Commit is the SHA of the commit the finding is in.
+	Commit string
+
+	// Path is a regular expression tested against the file path.
+	Path *regexp.Regexp
+
+	// Regex is a regular expression tested against the secret.
+	Regex *regexp.Regexp
+}
+
+// matches returns true if every field of the entry that is set matches.
+func (f AllowlistFinding) matches(commit, path, secret string) bool {
+	if f.Commit != "" && f.Commit != commit {
+		return false
+	}
+	if f.Path != nil && !regexMatched(path, f.Path) {
+		return false
+	}
+	if f.Regex != nil && !regexMatched(secret, f.Regex) {
+		return false
+	}
+	return true
+}
+
+// Allowlist conditions.
+const (
+	AllowlistConditionOR  = "OR"
+	AllowlistConditionAND = "AND"
+)
+
+// include adds the regexes, paths, commits and stop words of other to the
+// allowlist. Both allowlists must test their regexes against the same
+// target.
+func (a *Allowlist) include(other Allowlist) error {
+	if a.RegexTarget == "" && len(a.Regexes) == 0 {
+		a.RegexTarget = other.RegexTarget
+	} else if len(other.Regexes) > 0 && a.RegexTarget != other.RegexTarget {
+		return fmt.Errorf("conflicting regexTarget %q and %q", a.RegexTarget, other.RegexTarget)
+	}
+	if a.Condition == "" && len(a.Regexes) == 0 {
+		a.Condition = other.Condition
+	} else if len(other.Regexes) > 0 && !strings.EqualFold(a.condition(), other.condition()) {
+		return fmt.Errorf("conflicting condition %q and %q", a.condition(), other.condition())
+	}
+	a.Regexes = append(a.Regexes, other.Regexes...)
+	a.Paths = append(a.Paths, other.Paths...)
+	a.Commits = append(a.Commits, other.Commits...)
+	a.StopWords = append(a.StopWords, other.StopWords...)
+	a.Findings = append(a.Findings, other.Findings...)
+	a.LineHashes = append(a.LineHashes, other.LineHashes...)
+	a.Authors = append(a.Authors, other.Authors...)
+	return nil
 }
 
 // CommitAllowed returns true if the commit is allowed to be ignored.
@@ -53,11 +138,58 @@ func (a *Allowlist) PathAllowed(path string) bool {
 	return anyRegexMatch(path, a.Paths)
 }
 
+// FindingAllowed returns true if the finding of secret in the file at
+// path in commit is allowed to be ignored.
+func (a *Allowlist) FindingAllowed(commit, path, secret string) bool {
+	for _, f := range a.Findings {
+		if f.matches(commit, path, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// LineAllowed returns true if the hash of the line is allowed to be ignored.
+func (a *Allowlist) LineAllowed(line string) bool {
+	if len(a.LineHashes) == 0 {
+		return false
+	}
+	hash := LineHash(line)
+	for _, h := range a.LineHashes {
+		if strings.EqualFold(h, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorAllowed returns true if the commit author with the given name or
+// email is allowed to be ignored. Emails are compared case-insensitively.
+func (a *Allowlist) AuthorAllowed(name, email string) bool {
+	for _, author := range a.Authors {
+		if author == name || (email != "" && strings.EqualFold(author, email)) {
+			return true
+		}
+	}
+	return false
+}
+
 // RegexAllowed returns true if the regex is allowed to be ignored.
 func (a *Allowlist) RegexAllowed(s string) bool {
+	if strings.EqualFold(a.condition(), AllowlistConditionAND) {
+		return allRegexMatch(s, a.Regexes)
+	}
 	return anyRegexMatch(s, a.Regexes)
 }
 
+// condition returns the condition of the allowlist, defaulting to OR.
+func (a *Allowlist) condition() string {
+	if a.Condition == "" {
+		return AllowlistConditionOR
+	}
+	return a.Condition
+}
+
 func (a *Allowlist) ContainsStopWord(s string) bool {
 	s = strings.ToLower(s)
 	for _, stopWord := range a.StopWords {
@@ -66,6 +66,34 @@ func TestRegexAllowed(t *testing.T) {
 	}
 }
 
+func TestRegexAllowedCondition(t *testing.T) {
+	regexes := []*regexp.Regexp{
+		regexp.MustCompile(`dummy`),
+		regexp.MustCompile(`_test\.go`),
+	}
+	tests := []struct {
+		condition    string
+		line         string
+		regexAllowed bool
+	}{
+		{condition: "", line: "dummy key in api_test.go", regexAllowed: true},
+		{condition: "", line: "dummy key in api.go", regexAllowed: true},
+		{condition: "OR", line: "real key in api.go", regexAllowed: false},
+		{condition: "AND", line: "dummy key in api_test.go", regexAllowed: true},
+		{condition: "and", line: "dummy key in api_test.go", regexAllowed: true},
+		{condition: "AND", line: "dummy key in api.go", regexAllowed: false},
+		{condition: "AND", line: "real key in api_test.go", regexAllowed: false},
+	}
+	for _, tt := range tests {
+		allowlist := Allowlist{Condition: tt.condition, Regexes: regexes}
+		assert.Equal(t, tt.regexAllowed, allowlist.RegexAllowed(tt.line), "%s: %s", tt.condition, tt.line)
+	}
+
+	// an AND allowlist without regexes does not allow anything
+	empty := Allowlist{Condition: "AND"}
+	assert.False(t, empty.RegexAllowed("anything"))
+}
+
 func TestPathAllowed(t *testing.T) {
 	tests := []struct {
 		allowlist   Allowlist
@@ -91,3 +119,52 @@ func TestPathAllowed(t *testing.T) {
 		assert.Equal(t, tt.pathAllowed, tt.allowlist.PathAllowed(tt.path))
 	}
 }
+
+func TestFindingAllowed(t *testing.T) {
+	allowlist := Allowlist{
+		Findings: []AllowlistFinding{
+			{Commit: "commitA", Path: regexp.MustCompile(`^config\.go$`)},
+			{Path: regexp.MustCompile(`^main\.go$`), Regex: regexp.MustCompile("^knownsecret$")},
+		},
+	}
+	tests := []struct {
+		commit, path, secret string
+		findingAllowed       bool
+	}{
+		{"commitA", "config.go", "anysecret", true},
+		{"commitB", "config.go", "anysecret", false},
+		{"commitA", "other.go", "anysecret", false},
+		{"commitB", "main.go", "knownsecret", true},
+		{"", "main.go", "knownsecret", true},
+		{"commitA", "main.go", "othersecret", false},
+		{"commitA", "lib/main.go", "knownsecret", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.findingAllowed, allowlist.FindingAllowed(tt.commit, tt.path, tt.secret),
+			"%s %s %s", tt.commit, tt.path, tt.secret)
+	}
+}
+
+func TestLineAllowed(t *testing.T) {
+	line := `	password = "hunter2" // fixture`
+	a := Allowlist{LineHashes: []string{LineHash(line)}}
+
+	// printf '%s' 'password = "hunter2" // fixture' | sha256sum
+	assert.Equal(t, "ba132ca8d9a24ee925f0be607ac5c1649dbd9e028623368232895d22caa1ba3d", LineHash(line))
+	assert.True(t, a.LineAllowed(line))
+	// surrounding whitespace is ignored
+	assert.True(t, a.LineAllowed("\n"+line[1:]+"  "))
+	// any other change is not
+	assert.False(t, a.LineAllowed(`	password = "hunter3" // fixture`))
+	assert.False(t, (&Allowlist{}).LineAllowed(line))
+}
+
+func TestAuthorAllowed(t *testing.T) {
+	a := Allowlist{Authors: []string{"dependabot[bot]", "Renovate@WhiteSourceSoftware.com"}}
+
+	assert.True(t, a.AuthorAllowed("dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com"))
+	assert.True(t, a.AuthorAllowed("Renovate Bot", "renovate@whitesourcesoftware.com"))
+	assert.False(t, a.AuthorAllowed("Dependabot", "dependabot@example.com"))
+	assert.False(t, a.AuthorAllowed("", ""))
+	assert.False(t, (&Allowlist{}).AuthorAllowed("dependabot[bot]", ""))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	_ "embed"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -33,26 +34,65 @@ type ViperConfig struct {
 		SecretGroup int
 		Regex       string
 		Keywords    []string
+		KeywordsAll []string
 		Path        string
 		Tags        []string
+		Severity    string
+		Examples    []string
+
+		RemediationURL string
+
+		RequireContent  bool
+		EntropyRelative bool
+		AllowlistRefs   []string
 
 		Allowlist struct {
 			RegexTarget string
+			Condition   string
 			Regexes     []string
 			Paths       []string
 			Commits     []string
 			StopWords   []string
+			LineHashes  []string
+			Authors     []string
+			Findings    []ViperAllowlistFinding
 		}
 	}
 	Allowlist struct {
 		RegexTarget string
+		Condition   string
+		Regexes     []string
+		Paths       []string
+		Commits     []string
+		StopWords   []string
+		LineHashes  []string
+		Authors     []string
+		Findings    []ViperAllowlistFinding
+	}
+	// Allowlists are named allowlists that rules can share by listing
+	// their ids in allowlistRefs.
+	Allowlists []struct {
+		ID          string
+		Description string
+		RegexTarget string
+		Condition   string
 		Regexes     []string
 		Paths       []string
 		Commits     []string
 		StopWords   []string
+		LineHashes  []string
+		Authors     []string
+		Findings    []ViperAllowlistFinding
 	}
 }
 
+// ViperAllowlistFinding is an allowlist entry for a single known finding.
+type ViperAllowlistFinding struct {
+	Commit string
+	Path   string
+	Regex  string
+}
+
 // Config is a configuration struct that contains rules and an allowlist if present.
 type Config struct {
 	Extend      Extend
@@ -64,6 +104,11 @@ type Config struct {
 
 	// used to keep sarif results consistent
 	OrderedRules []string
+
+	// defaultDisabled holds the rules tagged TagDefaultDisabled. They are
+	// kept out of Rules, so no scan runs them unless FilterRulesByID
+	// enables them by id.
+	defaultDisabled map[string]Rule
 }
 
 // Extend is a struct that allows users to define how they want their
@@ -72,6 +117,10 @@ type Extend struct {
 	Path       string
 	URL        string
 	UseDefault bool
+
+	// DisabledRules lists ids of rules to remove from the config, for
+	// example rules pulled in from the default config that do not apply.
+	DisabledRules []string
 }
 
 func (vc *ViperConfig) Translate() (Config, error) {
@@ -81,14 +130,56 @@ func (vc *ViperConfig) Translate() (Config, error) {
 	)
 	rulesMap := make(map[string]Rule)
 
+	sharedAllowlists := make(map[string]Allowlist)
+	for _, a := range vc.Allowlists {
+		if a.ID == "" {
+			return Config{}, fmt.Errorf("shared allowlist %q is missing an id", a.Description)
+		}
+		if _, ok := sharedAllowlists[a.ID]; ok {
+			return Config{}, fmt.Errorf("shared allowlist %s is defined more than once", a.ID)
+		}
+		regexes, err := compileRegexes(a.Regexes)
+		if err != nil {
+			return Config{}, fmt.Errorf("shared allowlist %s invalid regex: %w", a.ID, err)
+		}
+		paths, err := compileRegexes(a.Paths)
+		if err != nil {
+			return Config{}, fmt.Errorf("shared allowlist %s invalid path: %w", a.ID, err)
+		}
+		findings, err := compileAllowlistFindings(a.Findings)
+		if err != nil {
+			return Config{}, fmt.Errorf("shared allowlist %s invalid finding: %w", a.ID, err)
+		}
+		sharedAllowlists[a.ID] = Allowlist{
+			Description: a.Description,
+			RegexTarget: a.RegexTarget,
+			Condition:   a.Condition,
+			Regexes:     regexes,
+			Paths:       paths,
+			Commits:     a.Commits,
+			StopWords:   a.StopWords,
+			LineHashes:  a.LineHashes,
+			Authors:     a.Authors,
+			Findings:    findings,
+		}
+	}
+
 	for _, r := range vc.Rules {
-		var allowlistRegexes []*regexp.Regexp
-		for _, a := range r.Allowlist.Regexes {
-			allowlistRegexes = append(allowlistRegexes, regexp.MustCompile(a))
+		// a second rule with the same id would silently replace the first
+		if _, ok := rulesMap[r.ID]; ok && r.ID != "" {
+			return Config{}, fmt.Errorf("rule id %s is defined more than once", r.ID)
+		}
+		allowlistRegexes, err := compileRegexes(r.Allowlist.Regexes)
+		if err != nil {
+			return Config{}, fmt.Errorf("%s invalid allowlist regex: %w", r.ID, err)
+		}
+		allowlistPaths, err := compileRegexes(r.Allowlist.Paths)
+		if err != nil {
+			return Config{}, fmt.Errorf("%s invalid allowlist path: %w", r.ID, err)
 		}
-		var allowlistPaths []*regexp.Regexp
-		for _, a := range r.Allowlist.Paths {
-			allowlistPaths = append(allowlistPaths, regexp.MustCompile(a))
+		allowlistFindings, err := compileAllowlistFindings(r.Allowlist.Findings)
+		if err != nil {
+			return Config{}, fmt.Errorf("%s invalid allowlist finding: %w", r.ID, err)
 		}
 
 		if r.Keywords == nil {
@@ -98,6 +189,9 @@ func (vc *ViperConfig) Translate() (Config, error) {
 				keywords = append(keywords, strings.ToLower(k))
 			}
 		}
+		for _, k := range r.KeywordsAll {
+			keywords = append(keywords, strings.ToLower(k))
+		}
 
 		if r.Tags == nil {
 			r.Tags = []string{}
@@ -105,32 +199,52 @@ func (vc *ViperConfig) Translate() (Config, error) {
 
 		var configRegex *regexp.Regexp
 		var configPathRegex *regexp.Regexp
-		if r.Regex == "" {
-			configRegex = nil
-		} else {
-			configRegex = regexp.MustCompile(r.Regex)
+		if r.Regex != "" {
+			if configRegex, err = regexp.Compile(r.Regex); err != nil {
+				return Config{}, fmt.Errorf("%s invalid regex: %w", r.ID, err)
+			}
 		}
-		if r.Path == "" {
-			configPathRegex = nil
-		} else {
-			configPathRegex = regexp.MustCompile(r.Path)
+		if r.Path != "" {
+			if configPathRegex, err = regexp.Compile(r.Path); err != nil {
+				return Config{}, fmt.Errorf("%s invalid path: %w", r.ID, err)
+			}
 		}
 		r := Rule{
-			Description: r.Description,
-			RuleID:      r.ID,
-			Regex:       configRegex,
-			Path:        configPathRegex,
-			SecretGroup: r.SecretGroup,
-			Entropy:     r.Entropy,
-			Tags:        r.Tags,
-			Keywords:    r.Keywords,
+			Description:     r.Description,
+			RuleID:          r.ID,
+			Regex:           configRegex,
+			Path:            configPathRegex,
+			SecretGroup:     r.SecretGroup,
+			RequireContent:  r.RequireContent,
+			Entropy:         r.Entropy,
+			EntropyRelative: r.EntropyRelative,
+			Tags:            r.Tags,
+			Severity:        r.Severity,
+			RemediationURL:  r.RemediationURL,
+			Keywords:        r.Keywords,
+			KeywordsAll:     r.KeywordsAll,
+			Examples:        r.Examples,
 			Allowlist: Allowlist{
 				RegexTarget: r.Allowlist.RegexTarget,
+				Condition:   r.Allowlist.Condition,
 				Regexes:     allowlistRegexes,
 				Paths:       allowlistPaths,
 				Commits:     r.Allowlist.Commits,
 				StopWords:   r.Allowlist.StopWords,
+				LineHashes:  r.Allowlist.LineHashes,
+				Authors:     r.Allowlist.Authors,
+				Findings:    allowlistFindings,
 			},
+			AllowlistRefs: r.AllowlistRefs,
+		}
+		for _, ref := range r.AllowlistRefs {
+			shared, ok := sharedAllowlists[ref]
+			if !ok {
+				return Config{}, fmt.Errorf("%s references unknown allowlist %s", r.RuleID, ref)
+			}
+			if err := r.Allowlist.include(shared); err != nil {
+				return Config{}, fmt.Errorf("%s cannot include allowlist %s: %w", r.RuleID, ref, err)
+			}
 		}
 		orderedRules = append(orderedRules, r.RuleID)
 
@@ -139,13 +253,17 @@ func (vc *ViperConfig) Translate() (Config, error) {
 		}
 		rulesMap[r.RuleID] = r
 	}
-	var allowlistRegexes []*regexp.Regexp
-	for _, a := range vc.Allowlist.Regexes {
-		allowlistRegexes = append(allowlistRegexes, regexp.MustCompile(a))
+	allowlistRegexes, err := compileRegexes(vc.Allowlist.Regexes)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid global allowlist regex: %w", err)
+	}
+	allowlistPaths, err := compileRegexes(vc.Allowlist.Paths)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid global allowlist path: %w", err)
 	}
-	var allowlistPaths []*regexp.Regexp
-	for _, a := range vc.Allowlist.Paths {
-		allowlistPaths = append(allowlistPaths, regexp.MustCompile(a))
+	allowlistFindings, err := compileAllowlistFindings(vc.Allowlist.Findings)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid global allowlist finding: %w", err)
 	}
 	c := Config{
 		Description: vc.Description,
@@ -153,14 +271,19 @@ func (vc *ViperConfig) Translate() (Config, error) {
 		Rules:       rulesMap,
 		Allowlist: Allowlist{
 			RegexTarget: vc.Allowlist.RegexTarget,
+			Condition:   vc.Allowlist.Condition,
 			Regexes:     allowlistRegexes,
 			Paths:       allowlistPaths,
 			Commits:     vc.Allowlist.Commits,
 			StopWords:   vc.Allowlist.StopWords,
+			LineHashes:  vc.Allowlist.LineHashes,
+			Authors:     vc.Allowlist.Authors,
+			Findings:    allowlistFindings,
 		},
 		Keywords:     keywords,
 		OrderedRules: orderedRules,
 	}
+	c.splitDefaultDisabled()
 
 	if maxExtendDepth != extendDepth {
 		// disallow both usedefault and path from being set
@@ -168,16 +291,71 @@ func (vc *ViperConfig) Translate() (Config, error) {
 			log.Fatal().Msg("unable to load config due to extend.path and extend.useDefault being set")
 		}
 		if c.Extend.UseDefault {
-			c.extendDefault()
+			if err := c.extendDefault(); err != nil {
+				return Config{}, err
+			}
 		} else if c.Extend.Path != "" {
-			c.extendPath()
+			if err := c.extendPath(); err != nil {
+				return Config{}, err
+			}
 		}
 
 	}
+	c.disableRules(c.Extend.DisabledRules)
 
 	return c, nil
 }
 
+// Load reads and translates the config file at path. Unlike the config
+// read by the CLI, it does not use the global viper instance, so it can be
+// used to load additional configs such as a repository's `.gitleaks.toml`.
+func Load(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(Format(path))
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, err
+	}
+	var vc ViperConfig
+	if err := v.Unmarshal(&vc); err != nil {
+		return Config{}, err
+	}
+	cfg, err := vc.Translate()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Path = path
+	return cfg, nil
+}
+
+// Default reads and translates the embedded default config.
+func Default() (Config, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(strings.NewReader(DefaultConfig)); err != nil {
+		return Config{}, err
+	}
+	var vc ViperConfig
+	if err := v.Unmarshal(&vc); err != nil {
+		return Config{}, err
+	}
+	return vc.Translate()
+}
+
+// Format returns the viper config type for the config file at path based on
+// its extension. Configs without a recognized extension, such as those read
+// from stdin, are treated as toml.
+func Format(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".yaml", ".yml":
+		return "yaml"
+	default:
+		return "toml"
+	}
+}
+
 func (c *Config) GetOrderedRules() []Rule {
 	var orderedRules []Rule
 	for _, id := range c.OrderedRules {
@@ -188,71 +366,67 @@ func (c *Config) GetOrderedRules() []Rule {
 	return orderedRules
 }
 
-func (c *Config) extendDefault() {
+func (c *Config) extendDefault() error {
 	extendDepth++
-	viper.SetConfigType("toml")
-	if err := viper.ReadConfig(strings.NewReader(DefaultConfig)); err != nil {
-		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
-	}
-	defaultViperConfig := ViperConfig{}
-	if err := viper.Unmarshal(&defaultViperConfig); err != nil {
-		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
-	}
-	cfg, err := defaultViperConfig.Translate()
+	cfg, err := Default()
 	if err != nil {
 		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
+		return nil
 	}
 	log.Debug().Msg("extending config with default config")
-	c.extend(cfg)
-
+	return c.extend(cfg)
 }
 
-func (c *Config) extendPath() {
+func (c *Config) extendPath() error {
 	extendDepth++
 	viper.SetConfigFile(c.Extend.Path)
+	viper.SetConfigType(Format(c.Extend.Path))
 	if err := viper.ReadInConfig(); err != nil {
 		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
+		return nil
 	}
 	extensionViperConfig := ViperConfig{}
 	if err := viper.Unmarshal(&extensionViperConfig); err != nil {
 		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
+		return nil
 	}
 	cfg, err := extensionViperConfig.Translate()
 	if err != nil {
 		log.Fatal().Msgf("failed to load extended config, err: %s", err)
-		return
+		return nil
 	}
 	log.Debug().Msgf("extending config with %s", c.Extend.Path)
-	c.extend(cfg)
+	return c.extend(cfg)
 }
 
 func (c *Config) extendURL() {
 	// TODO
 }
 
-func (c *Config) extend(extensionConfig Config) {
+func (c *Config) extend(extensionConfig Config) error {
+	// include the allowlist first, so a conflict leaves c untouched
+	allowlist := c.Allowlist
+	if err := allowlist.include(extensionConfig.Allowlist); err != nil {
+		return fmt.Errorf("could not extend global allowlist: %w", err)
+	}
+	c.Allowlist = allowlist
+
+	for ruleID, rule := range extensionConfig.defaultDisabled {
+		if _, ok := c.Rules[ruleID]; !ok && !c.isDefaultDisabled(ruleID) {
+			c.setDefaultDisabled(ruleID, rule)
+		}
+	}
 	for ruleID, rule := range extensionConfig.Rules {
-		if _, ok := c.Rules[ruleID]; !ok {
+		if _, ok := c.Rules[ruleID]; !ok && !c.isDefaultDisabled(ruleID) {
 			log.Trace().Msgf("adding %s to base config", ruleID)
 			c.Rules[ruleID] = rule
 			c.Keywords = append(c.Keywords, rule.Keywords...)
+			c.Keywords = append(c.Keywords, rule.KeywordsAll...)
 			c.OrderedRules = append(c.OrderedRules, ruleID)
 		}
 	}
 
-	// append allowlists, not attempting to merge
-	c.Allowlist.Commits = append(c.Allowlist.Commits,
-		extensionConfig.Allowlist.Commits...)
-	c.Allowlist.Paths = append(c.Allowlist.Paths,
-		extensionConfig.Allowlist.Paths...)
-	c.Allowlist.Regexes = append(c.Allowlist.Regexes,
-		extensionConfig.Allowlist.Regexes...)
-
 	// sort to keep extended rules in order
 	sort.Strings(c.OrderedRules)
+	return nil
 }
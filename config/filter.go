@@ -0,0 +1,107 @@
+package config
+
+import "sort"
+
+// FilterRulesByID limits the rules of the config to the rules with the ids in
+// enable, or all rules that are not tagged TagDefaultDisabled if enable is
+// empty, minus the rules with the ids in exclude. A rule that is both enabled
+// and excluded is excluded. Ids that are not the id of a rule are returned,
+// so the caller can warn about typos.
+//
+// The rules map and ordered rules are replaced rather than modified, so
+// copies of the config keep all rules.
+func (c *Config) FilterRulesByID(enable, exclude []string) (unknown []string) {
+	enabled := make(map[string]bool, len(enable))
+	for _, ruleID := range enable {
+		if _, ok := c.Rules[ruleID]; !ok && !c.isDefaultDisabled(ruleID) {
+			unknown = append(unknown, ruleID)
+		}
+		enabled[ruleID] = true
+	}
+	excluded := make(map[string]bool, len(exclude))
+	for _, ruleID := range exclude {
+		if _, ok := c.Rules[ruleID]; !ok && !c.isDefaultDisabled(ruleID) {
+			unknown = append(unknown, ruleID)
+		}
+		excluded[ruleID] = true
+	}
+
+	rules := make(map[string]Rule)
+	var orderedRules []string
+	for _, ruleID := range c.OrderedRules {
+		rule, ok := c.Rules[ruleID]
+		if !ok || excluded[ruleID] {
+			continue
+		}
+		if (len(enable) > 0 && !enabled[ruleID]) || (len(enable) == 0 && rule.defaultDisabled()) {
+			continue
+		}
+		rules[ruleID] = rule
+		orderedRules = append(orderedRules, ruleID)
+	}
+	// default disabled rules that are enabled by id run after the others
+	var enabledDisabled []string
+	for ruleID := range c.defaultDisabled {
+		if enabled[ruleID] && !excluded[ruleID] {
+			enabledDisabled = append(enabledDisabled, ruleID)
+		}
+	}
+	sort.Strings(enabledDisabled)
+	for _, ruleID := range enabledDisabled {
+		rules[ruleID] = c.defaultDisabled[ruleID]
+		orderedRules = append(orderedRules, ruleID)
+	}
+	c.Rules = rules
+	c.OrderedRules = orderedRules
+	c.Keywords = c.ruleKeywords()
+	return unknown
+}
+
+// defaultDisabled reports whether the rule is tagged TagDefaultDisabled.
+func (r Rule) defaultDisabled() bool {
+	for _, tag := range r.Tags {
+		if tag == TagDefaultDisabled {
+			return true
+		}
+	}
+	return false
+}
+
+// splitDefaultDisabled moves the rules tagged TagDefaultDisabled from Rules
+// to defaultDisabled.
+func (c *Config) splitDefaultDisabled() {
+	moved := false
+	for ruleID, rule := range c.Rules {
+		if rule.defaultDisabled() {
+			if c.defaultDisabled == nil {
+				c.defaultDisabled = make(map[string]Rule)
+			}
+			c.defaultDisabled[ruleID] = rule
+			delete(c.Rules, ruleID)
+			moved = true
+		}
+	}
+	if moved {
+		c.removeMissingRules()
+	}
+}
+
+// setDefaultDisabled adds rule to the rules that only run when enabled by
+// id, replacing a rule with the same id.
+func (c *Config) setDefaultDisabled(ruleID string, rule Rule) {
+	if c.defaultDisabled == nil {
+		c.defaultDisabled = make(map[string]Rule)
+	}
+	c.defaultDisabled[ruleID] = rule
+	if _, ok := c.Rules[ruleID]; ok {
+		delete(c.Rules, ruleID)
+		c.removeMissingRules()
+	}
+}
+
+// isDefaultDisabled reports whether the rule with the id only runs when it
+// is enabled by id.
+func (c *Config) isDefaultDisabled(ruleID string) bool {
+	_, ok := c.defaultDisabled[ruleID]
+	return ok
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func filterTestConfig() Config {
+	return Config{
+		Rules: map[string]Rule{
+			"aws-access-key": {
+				RuleID:   "aws-access-key",
+				Regex:    regexp.MustCompile(`AKIA[A-Z0-9]{16}`),
+				Keywords: []string{"akia"},
+			},
+			"github-pat": {
+				RuleID:   "github-pat",
+				Regex:    regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`),
+				Keywords: []string{"ghp_"},
+			},
+			"generic-api-key": {
+				RuleID:   "generic-api-key",
+				Regex:    regexp.MustCompile(`key=\w+`),
+				Keywords: []string{"key"},
+			},
+		},
+		OrderedRules: []string{"aws-access-key", "github-pat", "generic-api-key"},
+		Keywords:     []string{"akia", "ghp_", "key"},
+	}
+}
+
+func TestFilterRulesByID(t *testing.T) {
+	tests := map[string]struct {
+		enable       []string
+		exclude      []string
+		wantRules    []string
+		wantKeywords []string
+		wantUnknown  []string
+	}{
+		"no filter": {
+			wantRules:    []string{"aws-access-key", "github-pat", "generic-api-key"},
+			wantKeywords: []string{"akia", "ghp_", "key"},
+		},
+		"enable": {
+			enable:       []string{"github-pat", "aws-access-key"},
+			wantRules:    []string{"aws-access-key", "github-pat"},
+			wantKeywords: []string{"akia", "ghp_"},
+		},
+		"exclude": {
+			exclude:      []string{"generic-api-key"},
+			wantRules:    []string{"aws-access-key", "github-pat"},
+			wantKeywords: []string{"akia", "ghp_"},
+		},
+		"exclude wins over enable": {
+			enable:       []string{"github-pat", "generic-api-key"},
+			exclude:      []string{"generic-api-key"},
+			wantRules:    []string{"github-pat"},
+			wantKeywords: []string{"ghp_"},
+		},
+		"unknown ids": {
+			enable:       []string{"github-pat", "gihtub-pat"},
+			exclude:      []string{"slack-token"},
+			wantRules:    []string{"github-pat"},
+			wantKeywords: []string{"ghp_"},
+			wantUnknown:  []string{"gihtub-pat", "slack-token"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := filterTestConfig()
+			unknown := cfg.FilterRulesByID(tt.enable, tt.exclude)
+			assert.Equal(t, tt.wantUnknown, unknown)
+			assert.Equal(t, tt.wantRules, cfg.OrderedRules)
+			assert.Len(t, cfg.Rules, len(tt.wantRules))
+			for _, ruleID := range tt.wantRules {
+				assert.Contains(t, cfg.Rules, ruleID)
+			}
+			assert.Equal(t, tt.wantKeywords, cfg.Keywords)
+		})
+	}
+}
+
+func TestFilterRulesByIDKeepsCopies(t *testing.T) {
+	cfg := filterTestConfig()
+	filtered := cfg
+	filtered.FilterRulesByID(nil, []string{"aws-access-key"})
+
+	assert.Len(t, filtered.Rules, 2)
+	assert.Len(t, cfg.Rules, 3)
+	assert.Equal(t, []string{"aws-access-key", "github-pat", "generic-api-key"}, cfg.OrderedRules)
+}
+
+func TestFilterRulesByIDDefaultDisabled(t *testing.T) {
+	cfg := filterTestConfig()
+	cfg.Rules["high-entropy-hex"] = Rule{
+		RuleID: "high-entropy-hex",
+		Regex:  regexp.MustCompile(`[a-f0-9]{32,}`),
+		Tags:   []string{TagDefaultDisabled, "generic"},
+	}
+	cfg.OrderedRules = append(cfg.OrderedRules, "high-entropy-hex")
+
+	// not run by default
+	filtered := cfg
+	assert.Empty(t, filtered.FilterRulesByID(nil, nil))
+	assert.Equal(t, []string{"aws-access-key", "github-pat", "generic-api-key"}, filtered.OrderedRules)
+
+	// unless enabled by id
+	filtered = cfg
+	filtered.FilterRulesByID([]string{"high-entropy-hex", "github-pat"}, nil)
+	assert.Equal(t, []string{"github-pat", "high-entropy-hex"}, filtered.OrderedRules)
+}
+
+func TestDefaultDisabledNotLoaded(t *testing.T) {
+	cfg, err := Default()
+	require.NoError(t, err)
+
+	// library users that do not filter the rules do not run them
+	assert.NotContains(t, cfg.Rules, "high-entropy-hex")
+	assert.NotContains(t, cfg.OrderedRules, "high-entropy-hex")
+	assert.Contains(t, cfg.Rules, "aws-access-token")
+	assert.Contains(t, cfg.ruleIDs(), "high-entropy-hex")
+
+	filtered := cfg
+	assert.Empty(t, filtered.FilterRulesByID([]string{"high-entropy-hex"}, nil))
+	assert.Equal(t, []string{"high-entropy-hex"}, filtered.OrderedRules)
+
+	// a repository config can redefine the rule to run by default
+	override := Config{
+		Rules:        map[string]Rule{"high-entropy-hex": {RuleID: "high-entropy-hex", Regex: regexp.MustCompile(`[a-f0-9]{64}`)}},
+		OrderedRules: []string{"high-entropy-hex"},
+	}
+	require.NoError(t, cfg.Merge(override))
+	assert.Contains(t, cfg.Rules, "high-entropy-hex")
+	assert.False(t, cfg.isDefaultDisabled("high-entropy-hex"))
+}
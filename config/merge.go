@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Merge applies override on top of c. This lets a repository refine a
+// global config with its own `.gitleaks.toml`:
+//
+//   - rules in override replace the rules of c with the same id, other
+//     rules in override are added
+//   - rules listed in override's `[extend] disabledRules` are removed
+//   - the global allowlist of override is added to the global allowlist of c,
+//     both must use the same condition and regexTarget for their regexes
+//
+// c is unchanged if the allowlists conflict.
+func (c *Config) Merge(override Config) error {
+	allowlist := c.Allowlist
+	if err := allowlist.include(override.Allowlist); err != nil {
+		return fmt.Errorf("could not merge global allowlist: %w", err)
+	}
+	c.Allowlist = allowlist
+
+	if c.Rules == nil {
+		c.Rules = make(map[string]Rule)
+	}
+	for _, ruleID := range override.OrderedRules {
+		rule, ok := override.Rules[ruleID]
+		if !ok {
+			continue
+		}
+		if _, ok := c.Rules[ruleID]; ok {
+			log.Debug().Msgf("overriding rule %s", ruleID)
+		} else {
+			c.OrderedRules = append(c.OrderedRules, ruleID)
+		}
+		c.Rules[ruleID] = rule
+		delete(c.defaultDisabled, ruleID)
+	}
+	// a rule that override tags TagDefaultDisabled replaces the rule of c
+	// and does not run by default either
+	for ruleID, rule := range override.defaultDisabled {
+		c.setDefaultDisabled(ruleID, rule)
+	}
+
+	c.disableRules(override.Extend.DisabledRules)
+	c.Keywords = c.ruleKeywords()
+	return nil
+}
+
+// disableRules removes the rules with the given ids from the config.
+func (c *Config) disableRules(ruleIDs []string) {
+	if len(ruleIDs) == 0 {
+		return
+	}
+	for _, ruleID := range ruleIDs {
+		// a repository config may disable rules that are only defined in
+		// the config it is merged into, so unknown ids are not an error
+		if _, ok := c.Rules[ruleID]; ok {
+			log.Debug().Msgf("disabling rule %s", ruleID)
+			delete(c.Rules, ruleID)
+		}
+		delete(c.defaultDisabled, ruleID)
+	}
+	c.removeMissingRules()
+}
+
+// removeMissingRules removes the ids of rules that are no longer in Rules
+// from OrderedRules and updates Keywords.
+func (c *Config) removeMissingRules() {
+	orderedRules := c.OrderedRules[:0]
+	for _, ruleID := range c.OrderedRules {
+		if _, ok := c.Rules[ruleID]; ok {
+			orderedRules = append(orderedRules, ruleID)
+		}
+	}
+	c.OrderedRules = orderedRules
+	c.Keywords = c.ruleKeywords()
+}
+
+// ruleKeywords returns the keywords of all rules in the config.
+func (c *Config) ruleKeywords() []string {
+	var keywords []string
+	for _, ruleID := range c.OrderedRules {
+		for _, k := range c.Rules[ruleID].Keywords {
+			keywords = append(keywords, strings.ToLower(k))
+		}
+		for _, k := range c.Rules[ruleID].KeywordsAll {
+			keywords = append(keywords, strings.ToLower(k))
+		}
+	}
+	return keywords
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	global := Config{
+		Rules: map[string]Rule{
+			"aws-access-key": {
+				RuleID:   "aws-access-key",
+				Regex:    regexp.MustCompile(`AKIA[A-Z0-9]{16}`),
+				Keywords: []string{"akia"},
+			},
+			"aws-secret-key-again": {
+				RuleID:   "aws-secret-key-again",
+				Regex:    regexp.MustCompile(`(?i)aws_(.{0,20})?=?.[\'\"0-9a-zA-Z\/+]{40}`),
+				Keywords: []string{"aws_"},
+			},
+			"github-pat": {
+				RuleID:   "github-pat",
+				Regex:    regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`),
+				Keywords: []string{"ghp_"},
+			},
+		},
+		OrderedRules: []string{"aws-access-key", "aws-secret-key-again", "github-pat"},
+		Allowlist: Allowlist{
+			Paths: []*regexp.Regexp{regexp.MustCompile(`vendor/`)},
+		},
+		Keywords: []string{"akia", "aws_", "ghp_"},
+	}
+
+	override, err := Load(configPath + "repo_override.toml")
+	require.NoError(t, err)
+	require.NoError(t, global.Merge(override))
+
+	// rules are overridable by id
+	awsRule := global.Rules["aws-access-key"]
+	assert.Equal(t, "AWS Access Key with repo specific allowlist", awsRule.Description)
+	assert.True(t, awsRule.Allowlist.RegexAllowed("AKIAEXAMPLE"))
+
+	// disabled rules are removed
+	assert.NotContains(t, global.Rules, "aws-secret-key-again")
+	assert.Equal(t, []string{"aws-access-key", "github-pat"}, global.OrderedRules)
+	assert.ElementsMatch(t, []string{"ghp_"}, global.Keywords)
+
+	// allowlists are additive
+	assert.True(t, global.Allowlist.PathAllowed("vendor/lib.go"))
+	assert.True(t, global.Allowlist.PathAllowed("fixtures/keys.go"))
+	assert.False(t, global.Allowlist.PathAllowed("main.go"))
+}
+
+func TestMergeAllowlistConflict(t *testing.T) {
+	base := Config{
+		Allowlist: Allowlist{
+			Condition: AllowlistConditionAND,
+			Regexes:   []*regexp.Regexp{regexp.MustCompile(`example`), regexp.MustCompile(`test`)},
+		},
+	}
+	override := Config{
+		Allowlist: Allowlist{
+			Regexes: []*regexp.Regexp{regexp.MustCompile(`dummy`)},
+			Paths:   []*regexp.Regexp{regexp.MustCompile(`vendor/`)},
+		},
+	}
+	assert.Error(t, base.Merge(override))
+	assert.Len(t, base.Allowlist.Regexes, 2)
+	assert.Empty(t, base.Allowlist.Paths)
+
+	// an override without regexes only adds the other entries
+	override.Allowlist.Regexes = nil
+	require.NoError(t, base.Merge(override))
+	assert.Equal(t, AllowlistConditionAND, base.Allowlist.Condition)
+	assert.Len(t, base.Allowlist.Paths, 1)
+}
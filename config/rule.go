@@ -2,8 +2,27 @@ package config
 
 import (
 	"regexp"
+	"strings"
 )
 
+// Severities of rules, from lowest to highest.
+var Severities = []string{"low", "medium", "high", "critical"}
+
+// SeverityRank returns the position of severity in Severities, or -1 if
+// it is not a known severity. Severities are case insensitive.
+func SeverityRank(severity string) int {
+	for i, s := range Severities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+// TagDefaultDisabled marks rules that are too noisy to run by default. They
+// only run when enabled by id, see Config.FilterRulesByID.
+const TagDefaultDisabled = "default-disabled"
+
 // Rules contain information that define details on how to detect secrets
 type Rule struct {
 	// Description is the description of the rule.
@@ -16,6 +35,11 @@ type Rule struct {
 	// entropy a regex group must have to be considered a secret.
 	Entropy float64
 
+	// EntropyRelative makes Entropy a fraction (0-1) of the maximum
+	// shannon entropy of the checked string, log2 of its length, so the
+	// same threshold works for short and long secrets.
+	EntropyRelative bool
+
 	// SecretGroup is an int used to extract secret from regex
 	// match and used as the group that will have its entropy
 	// checked if `entropy` is set.
@@ -28,6 +52,20 @@ type Rule struct {
 	// filter secrets by path
 	Path *regexp.Regexp
 
+	// RequireContent makes a path only rule (a rule with a Path but no
+	// Regex) also require the file to have non-blank content. If Entropy
+	// is set, the content must also have a higher shannon entropy.
+	RequireContent bool
+
+	// Severity is one of "low", "medium", "high" or "critical". It is
+	// copied to findings and can be used to only fail on severe leaks.
+	Severity string
+
+	// RemediationURL links to documentation on how to revoke or rotate the
+	// secret, e.g. the API key settings of the provider. It is copied to
+	// findings.
+	RemediationURL string
+
 	// Tags is an array of strings used for metadata
 	// and reporting purposes.
 	Tags []string
@@ -37,7 +75,21 @@ type Rule struct {
 	// keyword(s) are in the content being scanned.
 	Keywords []string
 
+	// KeywordsAll are keywords that must all be in the content being
+	// scanned for the rule to be checked. Unlike Keywords, where any
+	// keyword is enough, this requires the keywords to co-occur.
+	KeywordsAll []string
+
+	// Examples are strings the rule is expected to match. They are not
+	// used during scans, only by SelfTest.
+	Examples []string
+
 	// Allowlist allows a rule to be ignored for specific
 	// regexes, paths, and/or commits
 	Allowlist Allowlist
+
+	// AllowlistRefs are the ids of shared allowlists defined with
+	// `[[allowlists]]`. They are merged into Allowlist when the config
+	// is loaded.
+	AllowlistRefs []string
 }
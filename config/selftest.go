@@ -0,0 +1,64 @@
+package config
+
+import "strings"
+
+// RuleResult is the outcome of SelfTest for one rule.
+type RuleResult struct {
+	RuleID string
+	// Examples is the number of examples of the rule and Matched the
+	// number of them the rule matched.
+	Examples int
+	Matched  int
+}
+
+// Passed reports whether the rule matched at least one of its examples.
+func (r RuleResult) Passed() bool {
+	return r.Matched > 0
+}
+
+// SelfTest checks each rule with examples against them and returns one
+// result per rule, in config order. An example matches if it contains the
+// rule's keywords and matches its regex. Entropy and allowlists are not
+// checked, so a passing rule can still skip an example during a scan, but
+// a failing rule cannot find its examples at all.
+func (c *Config) SelfTest() []RuleResult {
+	var results []RuleResult
+	for _, id := range c.ruleIDs() {
+		rule := c.ruleByID(id)
+		if len(rule.Examples) == 0 || rule.Regex == nil {
+			continue
+		}
+		result := RuleResult{RuleID: id, Examples: len(rule.Examples)}
+		for _, example := range rule.Examples {
+			if exampleMatches(rule, example) {
+				result.Matched++
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// exampleMatches reports whether a scan would check rule against example
+// and whether its regex matches.
+func exampleMatches(rule Rule, example string) bool {
+	lower := strings.ToLower(example)
+	if len(rule.Keywords) > 0 {
+		found := false
+		for _, k := range rule.Keywords {
+			if strings.Contains(lower, strings.ToLower(k)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, k := range rule.KeywordsAll {
+		if !strings.Contains(lower, strings.ToLower(k)) {
+			return false
+		}
+	}
+	return rule.Regex.MatchString(example)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"regexp"
 )
 
@@ -13,6 +14,19 @@ func anyRegexMatch(f string, res []*regexp.Regexp) bool {
 	return false
 }
 
+// allRegexMatch returns true if there are regexes and all of them match f.
+func allRegexMatch(f string, res []*regexp.Regexp) bool {
+	if len(res) == 0 {
+		return false
+	}
+	for _, re := range res {
+		if !regexMatched(f, re) {
+			return false
+		}
+	}
+	return true
+}
+
 func regexMatched(f string, re *regexp.Regexp) bool {
 	if re == nil {
 		return false
@@ -22,3 +36,44 @@ func regexMatched(f string, re *regexp.Regexp) bool {
 	}
 	return false
 }
+
+func compileRegexes(patterns []string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func compileAllowlistFindings(entries []ViperAllowlistFinding) ([]AllowlistFinding, error) {
+	var findings []AllowlistFinding
+	for _, e := range entries {
+		set := 0
+		for _, field := range []string{e.Commit, e.Path, e.Regex} {
+			if field != "" {
+				set++
+			}
+		}
+		if set < 2 {
+			return nil, fmt.Errorf("finding entry needs at least two of commit, path and regex")
+		}
+		f := AllowlistFinding{Commit: e.Commit}
+		var err error
+		if e.Path != "" {
+			if f.Path, err = regexp.Compile(e.Path); err != nil {
+				return nil, err
+			}
+		}
+		if e.Regex != "" {
+			if f.Regex, err = regexp.Compile(e.Regex); err != nil {
+				return nil, err
+			}
+		}
+		findings = append(findings, f)
+	}
+	return findings, nil
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidationErrors lists every problem found while validating a config.
+type ValidationErrors []error
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, err := range v {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks every rule and allowlist in the config and returns a
+// ValidationErrors listing all problems, or nil if the config is valid.
+func (c *Config) Validate() error {
+	var errs ValidationErrors
+	for _, id := range c.ruleIDs() {
+		rule := c.ruleByID(id)
+		if rule.RuleID == "" {
+			errs = append(errs, fmt.Errorf("rule with description %q is missing an id", rule.Description))
+		}
+		if rule.Regex == nil && rule.Path == nil {
+			errs = append(errs, fmt.Errorf("%s: rule must define a regex and/or a path", id))
+		}
+		// generic rules match almost any assignment, without keywords they
+		// run their regex against every fragment
+		if strings.HasPrefix(rule.RuleID, "generic") && rule.Regex != nil &&
+			len(rule.Keywords) == 0 && len(rule.KeywordsAll) == 0 {
+			errs = append(errs, fmt.Errorf("%s: generic rules must define keywords", id))
+		}
+		if rule.SecretGroup < 0 {
+			errs = append(errs, fmt.Errorf("%s: secretGroup %d must not be negative", id, rule.SecretGroup))
+		}
+		if rule.Regex != nil && rule.SecretGroup > rule.Regex.NumSubexp() {
+			errs = append(errs, fmt.Errorf("%s: secretGroup %d is out of range, regex has %d groups", id, rule.SecretGroup, rule.Regex.NumSubexp()))
+		}
+		if rule.Entropy < 0 {
+			errs = append(errs, fmt.Errorf("%s: entropy %v must not be negative", id, rule.Entropy))
+		}
+		if rule.EntropyRelative && (rule.Entropy <= 0 || rule.Entropy > 1) {
+			errs = append(errs, fmt.Errorf("%s: relative entropy %v must be a fraction between 0 and 1", id, rule.Entropy))
+		}
+		if rule.Regex == nil && rule.Entropy != 0 && !rule.RequireContent {
+			errs = append(errs, fmt.Errorf("%s: entropy is set but there is no regex to check it against", id))
+		}
+		if rule.RequireContent && (rule.Path == nil || rule.Regex != nil) {
+			errs = append(errs, fmt.Errorf("%s: requireContent only applies to rules with a path and no regex", id))
+		}
+		if rule.Severity != "" && SeverityRank(rule.Severity) == -1 {
+			errs = append(errs, fmt.Errorf("%s: unknown severity %q, must be one of %s", id, rule.Severity, strings.Join(Severities, ", ")))
+		}
+		if rule.RemediationURL != "" {
+			if u, err := url.Parse(rule.RemediationURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+				errs = append(errs, fmt.Errorf("%s: remediationURL %q must be an http or https url", id, rule.RemediationURL))
+			}
+		}
+		if err := validateRegexTarget(rule.Allowlist.RegexTarget); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+		}
+		if err := validateCondition(rule.Allowlist.Condition); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+		}
+		if err := validateLineHashes(rule.Allowlist.LineHashes); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+		}
+	}
+	if err := validateRegexTarget(c.Allowlist.RegexTarget); err != nil {
+		errs = append(errs, fmt.Errorf("global allowlist: %w", err))
+	}
+	if err := validateCondition(c.Allowlist.Condition); err != nil {
+		errs = append(errs, fmt.Errorf("global allowlist: %w", err))
+	}
+	if err := validateLineHashes(c.Allowlist.LineHashes); err != nil {
+		errs = append(errs, fmt.Errorf("global allowlist: %w", err))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// WarnBroadAllowlists returns a human readable warning for every allowlist
+// regex or path that matches everything, such as `.*`. These silently
+// suppress all findings of the rule, or of every rule for the global
+// allowlist, and are almost always a mistake.
+func WarnBroadAllowlists(cfg Config) []string {
+	var warnings []string
+	check := func(scope string, kind string, res []*regexp.Regexp) {
+		for _, re := range res {
+			if isBroadRegex(re) {
+				warnings = append(warnings, fmt.Sprintf("%s: allowlist %s %q matches everything", scope, kind, re.String()))
+			}
+		}
+	}
+	check("global allowlist", "regex", cfg.Allowlist.Regexes)
+	check("global allowlist", "path", cfg.Allowlist.Paths)
+	for _, id := range cfg.ruleIDs() {
+		allowlist := cfg.ruleByID(id).Allowlist
+		check(id, "regex", allowlist.Regexes)
+		check(id, "path", allowlist.Paths)
+	}
+	return warnings
+}
+
+// isBroadRegex reports whether re matches any input. Allowlist regexes are
+// not anchored, so a regex that matches the empty string matches anything.
+func isBroadRegex(re *regexp.Regexp) bool {
+	if re == nil {
+		return false
+	}
+	switch re.String() {
+	case ".+", "^.+$", "(.+)", "(?s).+":
+		return true
+	}
+	return re.MatchString("")
+}
+
+// ruleIDs returns the rule ids in the order they were defined followed by
+// any rules that were added to the map directly and the rules that only run
+// when enabled by id.
+func (c *Config) ruleIDs() []string {
+	var ids []string
+	seen := make(map[string]bool, len(c.Rules))
+	for _, id := range c.OrderedRules {
+		if _, ok := c.Rules[id]; ok && !seen[id] {
+			ids = append(ids, id)
+			seen[id] = true
+		}
+	}
+	var unordered []string
+	for id := range c.Rules {
+		if !seen[id] {
+			unordered = append(unordered, id)
+		}
+	}
+	sort.Strings(unordered)
+	ids = append(ids, unordered...)
+	// rules that only run when enabled by id are checked too
+	var disabled []string
+	for id := range c.defaultDisabled {
+		if !seen[id] {
+			disabled = append(disabled, id)
+		}
+	}
+	sort.Strings(disabled)
+	return append(ids, disabled...)
+}
+
+// ruleByID returns the rule with the id, including rules that only run
+// when enabled by id.
+func (c *Config) ruleByID(id string) Rule {
+	if rule, ok := c.Rules[id]; ok {
+		return rule
+	}
+	return c.defaultDisabled[id]
+}
+
+func validateRegexTarget(target string) error {
+	switch target {
+	case "", "match", "line":
+		return nil
+	}
+	return fmt.Errorf("unknown allowlist regexTarget %q, must be \"match\" or \"line\"", target)
+}
+
+func validateCondition(condition string) error {
+	if condition == "" || strings.EqualFold(condition, AllowlistConditionOR) ||
+		strings.EqualFold(condition, AllowlistConditionAND) {
+		return nil
+	}
+	return fmt.Errorf("unknown allowlist condition %q, must be \"OR\" or \"AND\"", condition)
+}
+
+var lineHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func validateLineHashes(hashes []string) error {
+	for _, hash := range hashes {
+		if !lineHashPattern.MatchString(hash) {
+			return fmt.Errorf("invalid allowlist lineHash %q, must be a hex encoded sha256 hash", hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		rule      Rule
+		allowlist Allowlist
+		wantError string
+	}{
+		"valid": {
+			rule: Rule{RuleID: "valid", Regex: regexp.MustCompile(`key-([a-f0-9]{32})`), SecretGroup: 1, Entropy: 3},
+		},
+		"missing id": {
+			rule:      Rule{Description: "no id", Regex: regexp.MustCompile(`key`)},
+			wantError: `rule with description "no id" is missing an id`,
+		},
+		"missing regex and path": {
+			rule:      Rule{RuleID: "empty"},
+			wantError: "empty: rule must define a regex and/or a path",
+		},
+		"generic rule with keywords": {
+			rule: Rule{RuleID: "generic-token", Regex: regexp.MustCompile(`token = "(\w+)"`), SecretGroup: 1, Keywords: []string{"token"}},
+		},
+		"generic rule without keywords": {
+			rule:      Rule{RuleID: "generic-token", Regex: regexp.MustCompile(`token = "(\w+)"`), SecretGroup: 1},
+			wantError: "generic-token: generic rules must define keywords",
+		},
+		"negative secret group": {
+			rule:      Rule{RuleID: "negative-group", Regex: regexp.MustCompile(`key`), SecretGroup: -1},
+			wantError: "negative-group: secretGroup -1 must not be negative",
+		},
+		"secret group out of range": {
+			rule:      Rule{RuleID: "big-group", Regex: regexp.MustCompile(`(key)`), SecretGroup: 2},
+			wantError: "big-group: secretGroup 2 is out of range, regex has 1 groups",
+		},
+		"negative entropy": {
+			rule:      Rule{RuleID: "negative-entropy", Regex: regexp.MustCompile(`key`), Entropy: -1},
+			wantError: "negative-entropy: entropy -1 must not be negative",
+		},
+		"relative entropy": {
+			rule: Rule{RuleID: "relative", Regex: regexp.MustCompile(`key-([a-f0-9]{32})`), SecretGroup: 1, Entropy: 0.8, EntropyRelative: true},
+		},
+		"relative entropy above 1": {
+			rule:      Rule{RuleID: "relative", Regex: regexp.MustCompile(`key`), Entropy: 3.5, EntropyRelative: true},
+			wantError: "relative: relative entropy 3.5 must be a fraction between 0 and 1",
+		},
+		"entropy without regex": {
+			rule:      Rule{RuleID: "path-entropy", Path: regexp.MustCompile(`\.pem$`), Entropy: 3},
+			wantError: "path-entropy: entropy is set but there is no regex to check it against",
+		},
+		"entropy on path rule requiring content": {
+			rule: Rule{RuleID: "key-file", Path: regexp.MustCompile(`id_rsa$`), Entropy: 3, RequireContent: true},
+		},
+		"require content with regex": {
+			rule:      Rule{RuleID: "key-content", Regex: regexp.MustCompile(`key`), RequireContent: true},
+			wantError: "key-content: requireContent only applies to rules with a path and no regex",
+		},
+		"severity": {
+			rule: Rule{RuleID: "severe", Regex: regexp.MustCompile(`key`), Severity: "High"},
+		},
+		"unknown severity": {
+			rule:      Rule{RuleID: "urgent", Regex: regexp.MustCompile(`key`), Severity: "urgent"},
+			wantError: `urgent: unknown severity "urgent", must be one of low, medium, high, critical`,
+		},
+		"remediation url": {
+			rule: Rule{RuleID: "docs", Regex: regexp.MustCompile(`key`), RemediationURL: "https://example.com/rotate"},
+		},
+		"relative remediation url": {
+			rule:      Rule{RuleID: "docs", Regex: regexp.MustCompile(`key`), RemediationURL: "docs/rotate.md"},
+			wantError: `docs: remediationURL "docs/rotate.md" must be an http or https url`,
+		},
+		"bad rule regex target": {
+			rule:      Rule{RuleID: "bad-target", Regex: regexp.MustCompile(`key`), Allowlist: Allowlist{RegexTarget: "secret"}},
+			wantError: `bad-target: unknown allowlist regexTarget "secret", must be "match" or "line"`,
+		},
+		"and condition": {
+			rule: Rule{RuleID: "and", Regex: regexp.MustCompile(`key`), Allowlist: Allowlist{Condition: "AND"}},
+		},
+		"bad rule condition": {
+			rule:      Rule{RuleID: "bad-condition", Regex: regexp.MustCompile(`key`), Allowlist: Allowlist{Condition: "XOR"}},
+			wantError: `bad-condition: unknown allowlist condition "XOR", must be "OR" or "AND"`,
+		},
+		"bad line hash": {
+			rule:      Rule{RuleID: "bad-hash", Regex: regexp.MustCompile(`key`), Allowlist: Allowlist{LineHashes: []string{"abc"}}},
+			wantError: `bad-hash: invalid allowlist lineHash "abc", must be a hex encoded sha256 hash`,
+		},
+		"bad global regex target": {
+			rule:      Rule{RuleID: "valid", Regex: regexp.MustCompile(`key`)},
+			allowlist: Allowlist{RegexTarget: "lines"},
+			wantError: `global allowlist: unknown allowlist regexTarget "lines", must be "match" or "line"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := Config{
+				Rules:     map[string]Rule{tt.rule.RuleID: tt.rule},
+				Allowlist: tt.allowlist,
+			}
+			err := cfg.Validate()
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantError)
+		})
+	}
+}
+
+func TestValidateListsEveryProblem(t *testing.T) {
+	cfg := Config{
+		Rules: map[string]Rule{
+			"a": {RuleID: "a"},
+			"b": {RuleID: "b", Regex: regexp.MustCompile(`key`), Entropy: -2},
+		},
+		OrderedRules: []string{"a", "b"},
+	}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Len(t, err.(ValidationErrors), 2)
+	assert.EqualError(t, err, "a: rule must define a regex and/or a path\nb: entropy -2 must not be negative")
+}
+
+func TestTranslateInvalidRegex(t *testing.T) {
+	viper.Reset()
+	viper.AddConfigPath(configPath)
+	viper.SetConfigName("invalid_regex")
+	viper.SetConfigType("toml")
+	require.NoError(t, viper.ReadInConfig())
+
+	var vc ViperConfig
+	require.NoError(t, viper.Unmarshal(&vc))
+	_, err := vc.Translate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broken-rule invalid regex")
+}
+
+func TestWarnBroadAllowlists(t *testing.T) {
+	cfg := Config{
+		Rules: map[string]Rule{
+			"aws-access-key": {
+				RuleID: "aws-access-key",
+				Regex:  regexp.MustCompile(`AKIA[A-Z0-9]{16}`),
+				Allowlist: Allowlist{
+					Regexes: []*regexp.Regexp{regexp.MustCompile(`AKIAEXAMPLE`), regexp.MustCompile(`.+`)},
+					Paths:   []*regexp.Regexp{regexp.MustCompile(`(?i)test.*`)},
+				},
+			},
+			"github-pat": {
+				RuleID: "github-pat",
+				Regex:  regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`),
+				Allowlist: Allowlist{
+					Regexes: []*regexp.Regexp{regexp.MustCompile(`(dummy)?`)},
+				},
+			},
+		},
+		OrderedRules: []string{"aws-access-key", "github-pat"},
+		Allowlist: Allowlist{
+			Paths: []*regexp.Regexp{regexp.MustCompile(`.*`), regexp.MustCompile(`vendor/`)},
+		},
+	}
+
+	assert.Equal(t, []string{
+		`global allowlist: allowlist path ".*" matches everything`,
+		`aws-access-key: allowlist regex ".+" matches everything`,
+		`github-pat: allowlist regex "(dummy)?" matches everything`,
+	}, WarnBroadAllowlists(cfg))
+}
+
+func TestWarnBroadAllowlistsDefaultConfig(t *testing.T) {
+	viper.Reset()
+	viper.SetConfigType("toml")
+	require.NoError(t, viper.ReadConfig(strings.NewReader(DefaultConfig)))
+
+	var vc ViperConfig
+	require.NoError(t, viper.Unmarshal(&vc))
+	cfg, err := vc.Translate()
+	require.NoError(t, err)
+	assert.Empty(t, WarnBroadAllowlists(cfg))
+}
@@ -0,0 +1,56 @@
+package detect
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"io"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+// DetectArchive scans the files of a tar archive read from r, for example
+// the output of `git archive`. Gzip compressed archives are detected
+// automatically. Entries are streamed from r, the archive is never
+// extracted to disk.
+func (d *Detector) DetectArchive(r io.Reader) ([]report.Finding, error) {
+	br := bufio.NewReader(r)
+	var archive io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return d.findings, err
+		}
+		defer gz.Close()
+		archive = gz
+	}
+
+	tr := tar.NewReader(archive)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return d.findings, err
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		if d.MaxTargetMegaBytes > 0 {
+			rawLength := header.Size / 1000000
+			if rawLength > int64(d.MaxTargetMegaBytes) {
+				log.Debug().Msgf("skipping file: %s scan due to size: %d", header.Name, rawLength)
+				continue
+			}
+		}
+
+		atomic.AddInt64(&d.scannedFiles, 1)
+		if err := d.detectChunks(tr, header.Name, ""); err != nil {
+			return d.findings, d.addScanError(header.Name, report.ScanStageRead, err)
+		}
+	}
+	return d.findings, nil
+}
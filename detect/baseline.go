@@ -74,6 +74,7 @@ func (d *Detector) AddBaseline(baselinePath string, source string) error {
 		}
 
 		d.baseline = baseline
+		d.baselineFile = absoluteBaseline
 		baselinePath = relativeBaseline
 
 	}
@@ -0,0 +1,112 @@
+package detect
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+// concatenationExtensions are the source languages in which "a" + "b"
+// concatenates two string literals.
+var concatenationExtensions = map[string]bool{
+	".go":     true,
+	".java":   true,
+	".kt":     true,
+	".scala":  true,
+	".groovy": true,
+	".cs":     true,
+	".js":     true,
+	".jsx":    true,
+	".mjs":    true,
+	".ts":     true,
+	".tsx":    true,
+	".py":     true,
+	".rb":     true,
+	".swift":  true,
+	".dart":   true,
+}
+
+// concatenationPattern matches two or more string literals joined with +,
+// with the + on the same line or an adjacent one. Literals with escapes are
+// not matched, so joining them never changes their value.
+var concatenationPattern = func() *regexp.Regexp {
+	literal := `(?:"[^"\\\n]*"|'[^'\\\n]*')`
+	plus := `[ \t]*\r?\n?[ \t]*\+[ \t]*\r?\n?[ \t]*`
+	return regexp.MustCompile(literal + `(?:` + plus + literal + `)+`)
+}()
+
+// concatenationLiteral matches a single string literal of a concatenation.
+var concatenationLiteral = regexp.MustCompile(`"([^"\\\n]*)"|'([^'\\\n]*)'`)
+
+// detectConcatenation joins string literal concatenations in source files
+// into a single literal and scans the lines they are on with every rule.
+// Only secrets that span more than one of the joined literals are reported,
+// the others are found by the regular scan.
+func (d *Detector) detectConcatenation(fragment Fragment) []report.Finding {
+	var findings []report.Finding
+	if !concatenationExtensions[strings.ToLower(path.Ext(fragment.FilePath))] {
+		return findings
+	}
+	fragment.newlineIndices = regexp.MustCompile("\n").FindAllStringIndex(fragment.Raw, -1)
+
+	for _, matchIndex := range concatenationPattern.FindAllStringIndex(fragment.Raw, -1) {
+		var parts []string
+		for _, m := range concatenationLiteral.FindAllStringSubmatch(fragment.Raw[matchIndex[0]:matchIndex[1]], -1) {
+			parts = append(parts, m[1]+m[2])
+		}
+
+		// the lines the concatenation is on
+		lineStart := strings.LastIndexByte(fragment.Raw[:matchIndex[0]], '\n') + 1
+		lineEnd := len(fragment.Raw)
+		if i := strings.IndexByte(fragment.Raw[matchIndex[1]:], '\n'); i >= 0 {
+			lineEnd = matchIndex[1] + i
+		}
+		line := fragment.Raw[lineStart:lineEnd]
+		if strings.Contains(line, gitleaksAllowSignature) && !d.IgnoreGitleaksAllow {
+			continue
+		}
+
+		// keep the code around the concatenation, rules often need the
+		// variable name or assignment before the secret
+		literal := strings.Join(parts, "")
+		joined := fragment.Raw[lineStart:matchIndex[0]] + `"` + literal + `"` +
+			fragment.Raw[matchIndex[1]:lineEnd]
+		loc := location(fragment, matchIndex)
+		joinedFragment := Fragment{
+			Raw:         joined,
+			FilePath:    fragment.FilePath,
+			SymlinkFile: fragment.SymlinkFile,
+			CommitSHA:   fragment.CommitSHA,
+		}
+		for _, finding := range d.detectRules(joinedFragment) {
+			if !spansParts(finding.Secret, literal, parts) {
+				continue
+			}
+			finding.StartLine = loc.startLine
+			finding.EndLine = loc.endLine
+			finding.StartColumn = loc.startColumn
+			finding.EndColumn = loc.endColumn
+			finding.Line = line
+			finding.Encoding = "concatenation"
+			finding.Encoded = fragment.Raw[matchIndex[0]:matchIndex[1]]
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// spansParts reports whether secret is in the joined literal, but not in
+// any single one of its parts.
+func spansParts(secret, literal string, parts []string) bool {
+	if !strings.Contains(literal, secret) {
+		return false
+	}
+	for _, part := range parts {
+		if strings.Contains(part, secret) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package detect
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+// maxDecodedSize is the largest decoded base64 string that is scanned.
+const maxDecodedSize = 64 * 1024
+
+// base64Pattern matches base64 encoded strings that are long enough to
+// hide a secret.
+var base64Pattern = regexp.MustCompile(`[A-Za-z0-9+/]{24,}={0,2}`)
+
+// detectBase64 decodes the base64 encoded strings in the fragment and scans
+// the decoded content with every rule. The decoded content is not decoded
+// again, so nested encodings are not followed.
+func (d *Detector) detectBase64(fragment Fragment) []report.Finding {
+	var findings []report.Finding
+	fragment.newlineIndices = regexp.MustCompile("\n").FindAllStringIndex(fragment.Raw, -1)
+
+	for _, matchIndex := range base64Pattern.FindAllStringIndex(fragment.Raw, -1) {
+		encoded := fragment.Raw[matchIndex[0]:matchIndex[1]]
+		decoded, ok := decodeBase64(encoded)
+		if !ok {
+			continue
+		}
+
+		loc := location(fragment, matchIndex)
+		line := fragment.Raw[loc.startLineIndex:loc.endLineIndex]
+		if strings.Contains(line, gitleaksAllowSignature) && !d.IgnoreGitleaksAllow {
+			continue
+		}
+
+		decodedFragment := Fragment{
+			Raw:         decoded,
+			FilePath:    fragment.FilePath,
+			SymlinkFile: fragment.SymlinkFile,
+			CommitSHA:   fragment.CommitSHA,
+		}
+		for _, finding := range d.detectRules(decodedFragment) {
+			finding.StartLine = loc.startLine
+			finding.EndLine = loc.endLine
+			finding.StartColumn = loc.startColumn
+			finding.EndColumn = loc.endColumn
+			finding.Line = line
+			finding.Encoding = "base64"
+			finding.Encoded = encoded
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// decodeBase64 decodes s if it is base64 encoded text no larger than
+// maxDecodedSize.
+func decodeBase64(s string) (string, bool) {
+	if base64.StdEncoding.DecodedLen(len(s)) > maxDecodedSize {
+		return "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		// the padding may have been stripped
+		decoded, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+		if err != nil {
+			return "", false
+		}
+	}
+	// binary content, such as images, is not scanned
+	if !utf8.Valid(decoded) {
+		return "", false
+	}
+	return string(decoded), true
+}
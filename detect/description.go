@@ -0,0 +1,54 @@
+package detect
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zricethezav/gitleaks/v8/config"
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+// descriptionData is the data available to rule descriptions written as
+// text/template templates, e.g. "{{.Keyword}} token in {{.File}}".
+type descriptionData struct {
+	// Offender is the secret, redacted if redaction is on.
+	Offender string
+	// Keyword is the first keyword of the rule found in the match.
+	Keyword string
+	File    string
+}
+
+// renderDescription expands the template placeholders of the rule
+// description for finding, redacting the secret first if redact is set.
+// Descriptions without "{{" are returned as is, and so are descriptions that
+// are not valid templates.
+func renderDescription(rule config.Rule, finding report.Finding, redact uint) string {
+	if !strings.Contains(rule.Description, "{{") {
+		return rule.Description
+	}
+	if redact > 0 {
+		finding.Redact(redact)
+	}
+	tmpl, err := template.New(rule.RuleID).Option("missingkey=error").Parse(rule.Description)
+	if err != nil {
+		log.Debug().Err(err).Msgf("rule %s: description is not a valid template", rule.RuleID)
+		return rule.Description
+	}
+
+	data := descriptionData{Offender: finding.Secret, File: finding.File}
+	match := strings.ToLower(finding.Match)
+	for _, k := range rule.Keywords {
+		if strings.Contains(match, strings.ToLower(k)) {
+			data.Keyword = k
+			break
+		}
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		log.Debug().Err(err).Msgf("rule %s: could not render description", rule.RuleID)
+		return rule.Description
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package detect
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func TestRenderDescription(t *testing.T) {
+	tests := map[string]struct {
+		description string
+		redact      uint
+		want        string
+	}{
+		"plain": {
+			description: "Mailgun private API token",
+			want:        "Mailgun private API token",
+		},
+		"template": {
+			description: "{{.Keyword}} credential {{.Offender}} in {{.File}}",
+			want:        "stripe credential sk_8f3kQ0vLz2 in config.env",
+		},
+		"redacted offender": {
+			description: "{{.Keyword}} credential {{.Offender}}",
+			redact:      100,
+			want:        "stripe credential REDACTED",
+		},
+		"malformed template": {
+			description: "{{.Keyword credential",
+			want:        "{{.Keyword credential",
+		},
+		"unknown field": {
+			description: "{{.Variable}} credential",
+			want:        "{{.Variable}} credential",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule := config.Rule{
+				RuleID:      "token",
+				Description: tt.description,
+				Regex:       regexp.MustCompile(`(?i)(?:stripe|mailgun)_key=(\w+)`),
+				Keywords:    []string{"mailgun", "stripe"},
+			}
+			detector := NewDetector(config.Config{
+				Rules:    map[string]config.Rule{rule.RuleID: rule},
+				Keywords: rule.Keywords,
+			})
+			detector.Redact = tt.redact
+			findings := detector.Detect(Fragment{Raw: "STRIPE_KEY=sk_8f3kQ0vLz2", FilePath: "config.env"})
+			require.Len(t, findings, 1)
+			assert.Equal(t, tt.want, findings[0].Description)
+		})
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/zricethezav/gitleaks/v8/config"
 	"github.com/zricethezav/gitleaks/v8/report"
+	"github.com/zricethezav/gitleaks/v8/verify"
 
 	ahocorasick "github.com/BobuSumisu/aho-corasick"
 	"github.com/fatih/semgroup"
@@ -22,6 +27,7 @@ import (
 const (
 	gitleaksAllowSignature = "gitleaks:allow"
 	chunkSize              = 10 * 1_000 // 10kb
+	defaultMaxLineBytes    = 1_000_000  // 1mb
 )
 
 // Detector is the main detector struct
@@ -49,10 +55,117 @@ type Detector struct {
 	// IgnoreGitleaksAllow is a flag to ignore gitleaks:allow comments.
 	IgnoreGitleaksAllow bool
 
+	// CheckActive marks findings from git history scans that are still
+	// present in the HEAD version of their file.
+	CheckActive bool
+
+	// headFiles caches file contents at HEAD for CheckActive.
+	headFiles      map[string]string
+	headFilesMutex *sync.Mutex
+
+	// MaxCommitMessageLength truncates the commit message attached to
+	// findings from git scans. 0 keeps the full message.
+	MaxCommitMessageLength int
+
+	// RelativeTo, if set, makes the file paths of findings from directory
+	// scans relative to this directory, matching the repository relative
+	// paths of git scans.
+	RelativeTo string
+
+	// Verifier, if set, checks whether the secrets of findings are live
+	// credentials. Verification makes network calls.
+	Verifier verify.Verifier
+
+	// MinConfidence drops findings with a lower confidence, see
+	// report.Score. 0 keeps all findings. Findings of path only rules have
+	// no secret to score and are always kept.
+	MinConfidence float64
+
+	// MaxMatchesPerRule caps the number of findings a single rule can add
+	// to the scan results. 0 means unlimited.
+	MaxMatchesPerRule int
+
+	// MaxScanBytes stops the scan once this many bytes of content have been
+	// scanned, which bounds the run time on unexpectedly large sources.
+	// Files skipped because of MaxTargetMegaBytes do not count. 0 means
+	// unlimited.
+	MaxScanBytes int64
+
+	// Deadline, if set, stops the scan once it has passed. Content that was
+	// not scanned by then is skipped and the findings so far are kept, which
+	// bounds the run time on pathological sources.
+	Deadline time.Time
+
+	// MaxLineBytes is the length of the longest line DetectLines accepts.
+	// It defaults to 1MB.
+	MaxLineBytes int
+
+	// SkipGenerated skips lockfiles and minified files, which are slow to
+	// scan and a common source of false positives.
+	SkipGenerated bool
+
+	// DecodeBase64 also scans the decoded content of base64 encoded
+	// strings. Findings are reported at the location of the encoded string.
+	DecodeBase64 bool
+
+	// JoinConcatenation also scans string literal concatenations in source
+	// files, such as "AKIA" + "IOSFODNN7EXAMPLE", as a single literal.
+	// Findings are reported at the location of the concatenation.
+	JoinConcatenation bool
+
+	// CommitMessageInclude and CommitMessageExclude, if set, limit git
+	// scans to commits whose message matches CommitMessageInclude and does
+	// not match CommitMessageExclude. A message matches if one of its lines
+	// does, like with `git log --grep`. They only skip commits after git
+	// produced their patches, sources.CommitMessageArgs lets git skip them.
+	CommitMessageInclude *regexp.Regexp
+	CommitMessageExclude *regexp.Regexp
+
+	// EntropyLog, if set, receives the rule id, shannon entropy and secret
+	// of every candidate match before its entropy is checked. It helps to
+	// pick entropy thresholds when writing rules.
+	EntropyLog      io.Writer
+	entropyLogMutex *sync.Mutex
+
+	// OnFinding, if set, is called with each finding as it is added to the
+	// scan results, so library users can stream findings instead of waiting
+	// for the scan to finish. Scans run in parallel, so it is called from
+	// several goroutines, but never concurrently: the detector holds its
+	// findings lock during the call. It must not call back into the
+	// detector.
+	OnFinding func(report.Finding)
+
+	// ruleMatches counts the findings of each rule, including those
+	// dropped because of MaxMatchesPerRule.
+	ruleMatches map[string]int
+
 	// commitMap is used to keep track of commits that have been scanned.
 	// This is only used for logging purposes and git scans.
 	commitMap map[string]bool
 
+	// scannedFiles counts the files, or file changes for git scans, that
+	// have been scanned. It is updated atomically.
+	scannedFiles int64
+
+	// scannedBytes and rulesEvaluated count the content scanned and the
+	// number of times a rule was checked against it. They are updated
+	// atomically.
+	scannedBytes   int64
+	rulesEvaluated int64
+
+	// budgetExceeded is set to 1 when content was left unscanned because of
+	// MaxScanBytes. It is updated atomically.
+	budgetExceeded int32
+
+	// timedOut is set to 1 when content was left unscanned because the
+	// Deadline passed. It is updated atomically.
+	timedOut int32
+
+	// scanErrors are the errors that did not stop the scan, guarded by
+	// scanErrorsMutex.
+	scanErrors      []report.ScanError
+	scanErrorsMutex *sync.Mutex
+
 	// findingMutex is to prevent concurrent access to the
 	// findings slice when adding findings.
 	findingMutex *sync.Mutex
@@ -69,9 +182,12 @@ type Detector struct {
 	// a list of known findings that should be ignored
 	baseline []report.Finding
 
-	// path to baseline
+	// path to baseline, relative to the source
 	baselinePath string
 
+	// absolute path to baseline
+	baselineFile string
+
 	// gitleaksIgnore
 	gitleaksIgnore map[string]bool
 
@@ -103,13 +219,18 @@ type Fragment struct {
 // NewDetector creates a new detector with the given config
 func NewDetector(cfg config.Config) *Detector {
 	return &Detector{
-		commitMap:      make(map[string]bool),
-		gitleaksIgnore: make(map[string]bool),
-		findingMutex:   &sync.Mutex{},
-		findings:       make([]report.Finding, 0),
-		Config:         cfg,
-		prefilter:      *ahocorasick.NewTrieBuilder().AddStrings(cfg.Keywords).Build(),
-		Sema:           semgroup.NewGroup(context.Background(), 40),
+		commitMap:       make(map[string]bool),
+		gitleaksIgnore:  make(map[string]bool),
+		findingMutex:    &sync.Mutex{},
+		ruleMatches:     make(map[string]int),
+		headFiles:       make(map[string]string),
+		headFilesMutex:  &sync.Mutex{},
+		entropyLogMutex: &sync.Mutex{},
+		scanErrorsMutex: &sync.Mutex{},
+		findings:        make([]report.Finding, 0),
+		Config:          cfg,
+		prefilter:       *ahocorasick.NewTrieBuilder().AddStrings(cfg.Keywords).Build(),
+		Sema:            semgroup.NewGroup(context.Background(), 40),
 	}
 }
 
@@ -149,9 +270,57 @@ func (d *Detector) AddGitleaksIgnore(gitleaksIgnorePath string) error {
 	scanner := bufio.NewScanner(file)
 
 	for scanner.Scan() {
-		d.gitleaksIgnore[scanner.Text()] = true
+		line := strings.TrimSpace(scanner.Text())
+		// skip blank lines and comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		d.gitleaksIgnore[line] = true
+	}
+	return scanner.Err()
+}
+
+// WriteGitleaksIgnore appends the fingerprints of findings to the
+// .gitleaksignore file at gitleaksIgnorePath, creating it if needed.
+// Fingerprints already present in the file are not written again.
+func WriteGitleaksIgnore(findings []report.Finding, gitleaksIgnorePath string) error {
+	existing := make(map[string]bool)
+	needsNewline := false
+	content, err := os.ReadFile(gitleaksIgnorePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+	// make sure the first appended fingerprint starts on a new line
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		needsNewline = true
+	}
+
+	file, err := os.OpenFile(gitleaksIgnorePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	for _, f := range findings {
+		if f.Fingerprint == "" || existing[f.Fingerprint] {
+			continue
+		}
+		existing[f.Fingerprint] = true
+		if needsNewline {
+			if _, err := w.WriteString("\n"); err != nil {
+				return err
+			}
+			needsNewline = false
+		}
+		if _, err := w.WriteString(f.Fingerprint + "\n"); err != nil {
+			return err
+		}
 	}
-	return nil
+	return w.Flush()
 }
 
 // DetectBytes scans the given bytes and returns a list of findings
@@ -166,12 +335,24 @@ func (d *Detector) DetectString(content string) []report.Finding {
 	})
 }
 
-// Detect scans the given fragment and returns a list of findings
+// Detect scans the given fragment and returns a list of findings. Like
+// the findings of DetectFiles and DetectGit, they pass the ignore file,
+// baseline and max matches per rule checks and are added to the detector's
+// findings.
 func (d *Detector) Detect(fragment Fragment) []report.Finding {
 	var findings []report.Finding
+	for _, finding := range d.detect(fragment) {
+		if finding, ok := d.addFinding(finding); ok {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
 
-	// initiate fragment keywords
-	fragment.keywords = make(map[string]bool)
+// detect scans the given fragment and returns a list of deduplicated
+// findings that have not been redacted yet.
+func (d *Detector) detect(fragment Fragment) []report.Finding {
+	var findings []report.Finding
 
 	// check if filepath is allowed
 	if fragment.FilePath != "" && (d.Config.Allowlist.PathAllowed(fragment.FilePath) ||
@@ -179,6 +360,39 @@ func (d *Detector) Detect(fragment Fragment) []report.Finding {
 		return findings
 	}
 
+	// check if the commit is allowed before doing any work for the rules,
+	// allowlisted commits are often large vendored or generated changes
+	if d.Config.Allowlist.CommitAllowed(fragment.CommitSHA) {
+		return findings
+	}
+
+	if d.SkipGenerated {
+		if reason := generatedReason(fragment); reason != "" {
+			log.Debug().Msgf("skipping generated file %s: %s", fragment.FilePath, reason)
+			return findings
+		}
+	}
+
+	if d.deadlinePassed() || !d.reserveScanBytes(int64(len(fragment.Raw))) {
+		return findings
+	}
+	findings = d.detectRules(fragment)
+	if d.DecodeBase64 {
+		findings = append(findings, d.detectBase64(fragment)...)
+	}
+	if d.JoinConcatenation {
+		findings = append(findings, d.detectConcatenation(fragment)...)
+	}
+	return filter(findings)
+}
+
+// detectRules scans the given fragment with every rule of the config.
+func (d *Detector) detectRules(fragment Fragment) []report.Finding {
+	var findings []report.Finding
+
+	// initiate fragment keywords
+	fragment.keywords = make(map[string]bool)
+
 	// add newline indices for location calculation in detectRule
 	fragment.newlineIndices = regexp.MustCompile("\n").FindAllStringIndex(fragment.Raw, -1)
 
@@ -190,6 +404,9 @@ func (d *Detector) Detect(fragment Fragment) []report.Finding {
 	}
 
 	for _, rule := range d.Config.Rules {
+		if !containsAllKeywords(fragment, rule.KeywordsAll) {
+			continue
+		}
 		if len(rule.Keywords) == 0 {
 			// if not keywords are associated with the rule always scan the
 			// fragment using the rule
@@ -207,12 +424,23 @@ func (d *Detector) Detect(fragment Fragment) []report.Finding {
 			findings = append(findings, d.detectRule(fragment, rule)...)
 		}
 	}
-	return filter(findings, d.Redact)
+	return findings
+}
+
+// containsAllKeywords returns true if every keyword is in the fragment.
+func containsAllKeywords(fragment Fragment, keywords []string) bool {
+	for _, k := range keywords {
+		if _, ok := fragment.keywords[strings.ToLower(k)]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 // detectRule scans the given fragment for the given rule and returns a list of findings
 func (d *Detector) detectRule(fragment Fragment, rule config.Rule) []report.Finding {
 	var findings []report.Finding
+	atomic.AddInt64(&d.rulesEvaluated, 1)
 
 	// check if filepath or commit is allowed for this rule
 	if rule.Allowlist.CommitAllowed(fragment.CommitSHA) ||
@@ -223,13 +451,30 @@ func (d *Detector) detectRule(fragment Fragment, rule config.Rule) []report.Find
 	if rule.Path != nil && rule.Regex == nil {
 		// Path _only_ rule
 		if rule.Path.MatchString(fragment.FilePath) {
+			var entropy float64
+			if rule.RequireContent {
+				content := strings.TrimSpace(fragment.Raw)
+				if content == "" {
+					return findings
+				}
+				entropy = shannonEntropy(content)
+				if rule.Entropy != 0.0 && entropy <= entropyThreshold(rule, content) {
+					// content is too uniform to be a secret, skip this finding
+					return findings
+				}
+			}
 			finding := report.Finding{
 				Description: rule.Description,
 				File:        fragment.FilePath,
 				SymlinkFile: fragment.SymlinkFile,
 				RuleID:      rule.RuleID,
 				Match:       fmt.Sprintf("file detected: %s", fragment.FilePath),
-				Tags:        rule.Tags,
+				Tags:        copyTags(rule.Tags),
+				SecretType:  secretType(rule),
+				Severity:    rule.Severity,
+				Entropy:     float32(entropy),
+
+				RemediationURL: rule.RemediationURL,
 			}
 			return append(findings, finding)
 		}
@@ -282,8 +527,12 @@ func (d *Detector) detectRule(fragment Fragment, rule config.Rule) []report.Find
 			EndColumn:   loc.endColumn,
 			Secret:      secret,
 			Match:       secret,
-			Tags:        rule.Tags,
+			Tags:        copyTags(rule.Tags),
+			SecretType:  secretType(rule),
+			Severity:    rule.Severity,
 			Line:        fragment.Raw[loc.startLineIndex:loc.endLineIndex],
+
+			RemediationURL: rule.RemediationURL,
 		}
 
 		if strings.Contains(fragment.Raw[loc.startLineIndex:loc.endLineIndex],
@@ -338,11 +587,23 @@ func (d *Detector) detectRule(fragment Fragment, rule config.Rule) []report.Find
 			continue
 		}
 
+		// check if this single finding is allowlisted
+		if rule.Allowlist.FindingAllowed(fragment.CommitSHA, fragment.FilePath, finding.Secret) ||
+			d.Config.Allowlist.FindingAllowed(fragment.CommitSHA, fragment.FilePath, finding.Secret) {
+			continue
+		}
+
+		// check if the exact line is allowlisted
+		if rule.Allowlist.LineAllowed(finding.Line) || d.Config.Allowlist.LineAllowed(finding.Line) {
+			continue
+		}
+
 		// check entropy
 		entropy := shannonEntropy(finding.Secret)
 		finding.Entropy = float32(entropy)
+		d.logEntropy(rule.RuleID, entropy, finding.Secret)
 		if rule.Entropy != 0.0 {
-			if entropy <= rule.Entropy {
+			if entropy <= entropyThreshold(rule, finding.Secret) {
 				// entropy is too low, skip this finding
 				continue
 			}
@@ -366,7 +627,7 @@ func (d *Detector) detectRule(fragment Fragment, rule config.Rule) []report.Find
 }
 
 // addFinding synchronously adds a finding to the findings slice
-func (d *Detector) addFinding(finding report.Finding) {
+func (d *Detector) addFinding(finding report.Finding) (report.Finding, bool) {
 	globalFingerprint := fmt.Sprintf("%s:%s:%d", finding.File, finding.RuleID, finding.StartLine)
 	if finding.Commit != "" {
 		finding.Fingerprint = fmt.Sprintf("%s:%s:%s:%d", finding.Commit, finding.File, finding.RuleID, finding.StartLine)
@@ -378,19 +639,54 @@ func (d *Detector) addFinding(finding report.Finding) {
 	if _, ok := d.gitleaksIgnore[globalFingerprint]; ok {
 		log.Debug().Msgf("ignoring finding with global Fingerprint %s",
 			finding.Fingerprint)
-		return
+		return finding, false
 	} else if finding.Commit != "" {
 		// Awkward nested if because I'm not sure how to chain these two conditions.
 		if _, ok := d.gitleaksIgnore[finding.Fingerprint]; ok {
 			log.Debug().Msgf("ignoring finding with Fingerprint %s",
 				finding.Fingerprint)
-			return
+			return finding, false
 		}
 	}
 
-	if d.baseline != nil && !IsNew(finding, d.baseline) {
+	// render before the baseline check, baselines are reports and hold the
+	// rendered description
+	finding.Description = renderDescription(d.Config.Rules[finding.RuleID], finding, d.Redact)
+
+	rule := d.Config.Rules[finding.RuleID]
+	finding.Confidence = report.Score(finding, rule)
+	if finding.Confidence < d.MinConfidence && rule.Regex != nil {
+		log.Debug().Msgf("confidence %.2f below minimum -- ignoring finding with Fingerprint %s", finding.Confidence, finding.Fingerprint)
+		return finding, false
+	}
+
+	// a baseline written with --redact holds redacted findings, so compare
+	// a redacted copy. The finding itself stays unredacted until it was
+	// verified.
+	baselineFinding := finding
+	if d.Redact > 0 {
+		baselineFinding.Redact(d.Redact)
+	}
+	if d.baseline != nil && !IsNew(baselineFinding, d.baseline) {
 		log.Debug().Msgf("baseline duplicate -- ignoring finding with Fingerprint %s", finding.Fingerprint)
-		return
+		return finding, false
+	}
+
+	d.findingMutex.Lock()
+	d.ruleMatches[finding.RuleID]++
+	truncated := d.MaxMatchesPerRule > 0 && d.ruleMatches[finding.RuleID] > d.MaxMatchesPerRule
+	d.findingMutex.Unlock()
+	if truncated {
+		return finding, false
+	}
+
+	// only verify findings that are reported, verification makes network
+	// calls. It needs the secret, so it must happen before redaction.
+	if d.Verifier != nil {
+		finding.Verified = d.verify(finding)
+	}
+	if d.Redact > 0 {
+		finding.Redact(d.Redact)
 	}
 
 	d.findingMutex.Lock()
@@ -398,10 +694,179 @@ func (d *Detector) addFinding(finding report.Finding) {
 	if d.Verbose {
 		printFinding(finding, d.NoColor)
 	}
+	if d.OnFinding != nil {
+		d.OnFinding(finding)
+	}
 	d.findingMutex.Unlock()
+	return finding, true
+}
+
+// verify reports whether the secret of finding is a live credential. Errors
+// are recorded as scan errors and the finding is reported as not verified.
+func (d *Detector) verify(finding report.Finding) bool {
+	active, err := d.Verifier.Verify(finding)
+	if err != nil {
+		log.Debug().Err(err).Msgf("could not verify finding with Fingerprint %s", finding.Fingerprint)
+		d.addScanError(finding.Fingerprint, report.ScanStageVerify, err)
+	}
+	return active
+}
+
+// sortFindings orders the findings by commit date, commit, file and
+// location, so results do not depend on the order in which the parallel
+// scanners finished. Dates are UTC RFC 3339 strings, which sort
+// chronologically.
+func (d *Detector) sortFindings() {
+	d.findingMutex.Lock()
+	defer d.findingMutex.Unlock()
+	sort.SliceStable(d.findings, func(i, j int) bool {
+		a, b := d.findings[i], d.findings[j]
+		switch {
+		case a.Date != b.Date:
+			return a.Date < b.Date
+		case a.Commit != b.Commit:
+			return a.Commit < b.Commit
+		case a.File != b.File:
+			return a.File < b.File
+		case a.StartLine != b.StartLine:
+			return a.StartLine < b.StartLine
+		case a.StartColumn != b.StartColumn:
+			return a.StartColumn < b.StartColumn
+		default:
+			return a.RuleID < b.RuleID
+		}
+	})
+}
+
+// TruncatedRules returns the total number of findings for each rule that
+// exceeded MaxMatchesPerRule. Only the first MaxMatchesPerRule findings of
+// these rules are included in the scan results.
+func (d *Detector) TruncatedRules() map[string]int {
+	d.findingMutex.Lock()
+	defer d.findingMutex.Unlock()
+	truncated := make(map[string]int)
+	if d.MaxMatchesPerRule == 0 {
+		return truncated
+	}
+	for ruleID, count := range d.ruleMatches {
+		if count > d.MaxMatchesPerRule {
+			truncated[ruleID] = count
+		}
+	}
+	return truncated
+}
+
+// CommitsScanned returns the number of commits scanned by DetectGit.
+func (d *Detector) CommitsScanned() int {
+	return len(d.commitMap)
+}
+
+// FilesScanned returns the number of files scanned by DetectFiles, or the
+// number of file changes scanned by DetectGit.
+func (d *Detector) FilesScanned() int {
+	return int(atomic.LoadInt64(&d.scannedFiles))
+}
+
+// scanBudgetSpent reports whether MaxScanBytes have been scanned. It is
+// called before scanning more content, so it also records that the scan
+// is incomplete.
+func (d *Detector) scanBudgetSpent() bool {
+	if d.MaxScanBytes <= 0 || atomic.LoadInt64(&d.scannedBytes) < d.MaxScanBytes {
+		return false
+	}
+	atomic.StoreInt32(&d.budgetExceeded, 1)
+	return true
+}
+
+// deadlinePassed reports whether the Deadline has passed. Like
+// scanBudgetSpent it is called before scanning more content and records
+// that the scan is incomplete.
+func (d *Detector) deadlinePassed() bool {
+	if d.Deadline.IsZero() || time.Now().Before(d.Deadline) {
+		return false
+	}
+	atomic.StoreInt32(&d.timedOut, 1)
+	return true
+}
+
+// scanStopped reports whether no more content should be scanned, either
+// because MaxScanBytes have been scanned or because the Deadline passed.
+func (d *Detector) scanStopped() bool {
+	// check both so each records why the scan is incomplete
+	spent := d.scanBudgetSpent()
+	return d.deadlinePassed() || spent
+}
+
+// reserveScanBytes counts n bytes as scanned and reports whether they may
+// be scanned. Fragments are scanned in parallel, so the check and the count
+// are a single atomic step: only fragments started while the total was below
+// MaxScanBytes are scanned.
+func (d *Detector) reserveScanBytes(n int64) bool {
+	scanned := atomic.AddInt64(&d.scannedBytes, n)
+	if d.MaxScanBytes > 0 && scanned-n >= d.MaxScanBytes {
+		atomic.AddInt64(&d.scannedBytes, -n)
+		atomic.StoreInt32(&d.budgetExceeded, 1)
+		return false
+	}
+	return true
+}
+
+// ScanBudgetExceeded reports whether the scan stopped early because of
+// MaxScanBytes.
+func (d *Detector) ScanBudgetExceeded() bool {
+	return atomic.LoadInt32(&d.budgetExceeded) == 1
+}
+
+// TimedOut reports whether the scan stopped early because the Deadline
+// passed.
+func (d *Detector) TimedOut() bool {
+	return atomic.LoadInt32(&d.timedOut) == 1
+}
+
+// addScanError records an error that did not stop the scan and returns it.
+func (d *Detector) addScanError(source, stage string, err error) error {
+	scanErr := report.ScanError{Source: source, Stage: stage, Err: err}
+	d.scanErrorsMutex.Lock()
+	d.scanErrors = append(d.scanErrors, scanErr)
+	d.scanErrorsMutex.Unlock()
+	return scanErr
+}
+
+// ScanErrors returns the errors that did not stop the scan, such as files
+// that could not be read, in the order they happened. Scans also return
+// these errors, but combined into one.
+func (d *Detector) ScanErrors() []report.ScanError {
+	d.scanErrorsMutex.Lock()
+	defer d.scanErrorsMutex.Unlock()
+	return append([]report.ScanError(nil), d.scanErrors...)
+}
+
+// Metrics returns the counters of the content scanned so far. The
+// duration is left for the caller to set since the detector does not know
+// when the scan started.
+func (d *Detector) Metrics() report.Metrics {
+	return report.Metrics{
+		ScannedCommits: d.CommitsScanned(),
+		ScannedFiles:   d.FilesScanned(),
+		ScannedBytes:   atomic.LoadInt64(&d.scannedBytes),
+		RulesEvaluated: atomic.LoadInt64(&d.rulesEvaluated),
+		BudgetExceeded: d.ScanBudgetExceeded(),
+		TimedOut:       d.TimedOut(),
+	}
 }
 
 // addCommit synchronously adds a commit to the commit slice
 func (d *Detector) addCommit(commit string) {
 	d.commitMap[commit] = true
 }
+
+// logEntropy writes a candidate match to EntropyLog as a tab separated
+// line: rule id, entropy and the quoted secret.
+func (d *Detector) logEntropy(ruleID string, entropy float64, secret string) {
+	if d.EntropyLog == nil {
+		return
+	}
+	d.entropyLogMutex.Lock()
+	defer d.entropyLogMutex.Unlock()
+	fmt.Fprintf(d.EntropyLog, "%s\t%.4f\t%q\n", ruleID, entropy, secret)
+}
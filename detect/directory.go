@@ -3,7 +3,9 @@ package detect
 import (
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/h2non/filetype"
 	"github.com/rs/zerolog/log"
@@ -14,18 +16,27 @@ import (
 func (d *Detector) DetectFiles(paths <-chan sources.ScanTarget) ([]report.Finding, error) {
 	for pa := range paths {
 		p := pa
+		// keep draining paths so the walk can finish
+		if d.scanStopped() {
+			continue
+		}
+		// check before the path is made relative, the config and baseline
+		// paths are not
+		if d.isOwnFile(p.Path) {
+			continue
+		}
 		d.Sema.Go(func() error {
 
 			f, err := os.Open(p.Path)
 			if err != nil {
-				return err
+				return d.addScanError(p.Path, report.ScanStageRead, err)
 			}
 			defer f.Close()
 
 			// Get file size
 			fileInfo, err := f.Stat()
 			if err != nil {
-				return err
+				return d.addScanError(p.Path, report.ScanStageRead, err)
 			}
 			fileSize := fileInfo.Size()
 			if d.MaxTargetMegaBytes > 0 {
@@ -36,45 +47,15 @@ func (d *Detector) DetectFiles(paths <-chan sources.ScanTarget) ([]report.Findin
 				}
 			}
 
-			// Buffer to hold file chunks
-			buf := make([]byte, chunkSize)
-			totalLines := 0
-			for {
-				n, err := f.Read(buf)
-				if err != nil && err != io.EOF {
-					return err
-				}
-				if n == 0 {
-					break
-				}
-
-				// TODO: optimization could be introduced here
-				mimetype, err := filetype.Match(buf[:n])
-				if err != nil {
-					return err
-				}
-				if mimetype.MIME.Type == "application" {
-					return nil // skip binary files
-				}
+			atomic.AddInt64(&d.scannedFiles, 1)
 
-				// Count the number of newlines in this chunk
-				linesInChunk := strings.Count(string(buf[:n]), "\n")
-				totalLines += linesInChunk
-				fragment := Fragment{
-					Raw:      string(buf[:n]),
-					FilePath: p.Path,
-				}
-				if p.Symlink != "" {
-					fragment.SymlinkFile = p.Symlink
-				}
-				for _, finding := range d.Detect(fragment) {
-					// need to add 1 since line counting starts at 1
-					finding.StartLine += (totalLines - linesInChunk) + 1
-					finding.EndLine += (totalLines - linesInChunk) + 1
-					d.addFinding(finding)
-				}
+			symlink := ""
+			if p.Symlink != "" {
+				symlink = d.relativePath(p.Symlink)
+			}
+			if err := d.detectChunks(f, d.relativePath(p.Path), symlink); err != nil {
+				return d.addScanError(p.Path, report.ScanStageRead, err)
 			}
-
 			return nil
 		})
 	}
@@ -82,6 +63,81 @@ func (d *Detector) DetectFiles(paths <-chan sources.ScanTarget) ([]report.Findin
 	if err := d.Sema.Wait(); err != nil {
 		return d.findings, err
 	}
+	d.sortFindings()
 
 	return d.findings, nil
 }
+
+// detectChunks scans the content of a file in chunks and adds the findings
+// with line numbers relative to the start of the file. Binary files are
+// skipped.
+func (d *Detector) detectChunks(r io.Reader, filePath string, symlinkFile string) error {
+	// Buffer to hold file chunks
+	buf := make([]byte, chunkSize)
+	totalLines := 0
+	for {
+		// readers such as archives may return short reads, fill the
+		// buffer so chunks are only split at chunkSize
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if n == 0 || d.scanStopped() {
+			break
+		}
+
+		// TODO: optimization could be introduced here
+		mimetype, err := filetype.Match(buf[:n])
+		if err != nil {
+			return err
+		}
+		if mimetype.MIME.Type == "application" {
+			return nil // skip binary files
+		}
+
+		// Count the number of newlines in this chunk
+		linesInChunk := strings.Count(string(buf[:n]), "\n")
+		totalLines += linesInChunk
+		fragment := Fragment{
+			Raw:         string(buf[:n]),
+			FilePath:    filePath,
+			SymlinkFile: symlinkFile,
+		}
+		for _, finding := range d.detect(fragment) {
+			// need to add 1 since line counting starts at 1
+			finding.StartLine += (totalLines - linesInChunk) + 1
+			finding.EndLine += (totalLines - linesInChunk) + 1
+			d.addFinding(finding)
+		}
+	}
+	return nil
+}
+
+// isOwnFile reports whether path is the config or the baseline file of the
+// scan, which are not scanned.
+func (d *Detector) isOwnFile(path string) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	if d.Config.Path != "" {
+		if configPath, err := filepath.Abs(d.Config.Path); err == nil && abs == configPath {
+			return true
+		}
+	}
+	return d.baselineFile != "" && abs == d.baselineFile
+}
+
+// relativePath returns path relative to d.RelativeTo using forward slashes.
+// Paths outside of d.RelativeTo are returned unchanged.
+func (d *Detector) relativePath(path string) string {
+	if d.RelativeTo == "" {
+		return path
+	}
+	rel, err := filepath.Rel(d.RelativeTo, path)
+	rel = filepath.ToSlash(rel)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return path
+	}
+	return rel
+}
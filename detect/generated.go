@@ -0,0 +1,55 @@
+package detect
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// generatedFileNames are lockfiles and other files written by tools.
+var generatedFileNames = map[string]bool{
+	"package-lock.json":   true,
+	"npm-shrinkwrap.json": true,
+	"yarn.lock":           true,
+	"pnpm-lock.yaml":      true,
+	"composer.lock":       true,
+	"Gemfile.lock":        true,
+	"Cargo.lock":          true,
+	"poetry.lock":         true,
+	"go.sum":              true,
+}
+
+// generatedFileSuffixes are extensions of minified files.
+var generatedFileSuffixes = []string{".min.js", ".min.css", ".min.mjs"}
+
+const (
+	// maxAverageLineLength is the average line length above which content
+	// is considered minified.
+	maxAverageLineLength = 500
+
+	// minGeneratedSize is the smallest content checked for long lines, so
+	// a short file with a single long secret on one line is still scanned.
+	minGeneratedSize = 4096
+)
+
+// generatedReason returns why the fragment looks like a generated or
+// minified file, or "" if it does not.
+func generatedReason(fragment Fragment) string {
+	name := path.Base(strings.ReplaceAll(fragment.FilePath, "\\", "/"))
+	if generatedFileNames[name] {
+		return "generated file name"
+	}
+	for _, suffix := range generatedFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return fmt.Sprintf("%s extension", suffix)
+		}
+	}
+	if len(fragment.Raw) < minGeneratedSize {
+		return ""
+	}
+	lines := strings.Count(fragment.Raw, "\n") + 1
+	if average := len(fragment.Raw) / lines; average > maxAverageLineLength {
+		return fmt.Sprintf("average line length %d", average)
+	}
+	return ""
+}
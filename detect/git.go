@@ -1,6 +1,10 @@
 package detect
 
 import (
+	"regexp"
+	"strings"
+	"sync/atomic"
+
 	"github.com/gitleaks/go-gitdiff/gitdiff"
 	"github.com/rs/zerolog/log"
 	"github.com/zricethezav/gitleaks/v8/report"
@@ -11,6 +15,7 @@ func (d *Detector) DetectGit(gitCmd *sources.GitCmd) ([]report.Finding, error) {
 	defer gitCmd.Wait()
 	diffFilesCh := gitCmd.DiffFilesCh()
 	errCh := gitCmd.ErrCh()
+	killed := false
 
 	// loop to range over both DiffFiles (stdout) and ErrCh (stderr)
 	for diffFilesCh != nil || errCh != nil {
@@ -33,8 +38,28 @@ func (d *Detector) DetectGit(gitCmd *sources.GitCmd) ([]report.Finding, error) {
 				if d.Config.Allowlist.CommitAllowed(gitdiffFile.PatchHeader.SHA) {
 					continue
 				}
+				if author := gitdiffFile.PatchHeader.Author; author != nil &&
+					d.Config.Allowlist.AuthorAllowed(author.Name, author.Email) {
+					continue
+				}
+				if !d.commitMessageScanned(gitdiffFile.PatchHeader.Message()) {
+					continue
+				}
+			}
+			// stop git once the deadline passed, the rest of a long log
+			// would take as long to read as to scan
+			if d.deadlinePassed() && !killed {
+				killed = true
+				if err := gitCmd.Kill(); err != nil {
+					log.Debug().Err(err).Msg("could not stop git")
+				}
+			}
+			// keep reading the log so git can exit
+			if d.scanStopped() {
+				continue
 			}
 			d.addCommit(commitSHA)
+			atomic.AddInt64(&d.scannedFiles, 1)
 
 			d.Sema.Go(func() error {
 				for _, textFragment := range gitdiffFile.TextFragments {
@@ -48,8 +73,17 @@ func (d *Detector) DetectGit(gitCmd *sources.GitCmd) ([]report.Finding, error) {
 						FilePath:  gitdiffFile.NewName,
 					}
 
-					for _, finding := range d.Detect(fragment) {
-						d.addFinding(augmentGitFinding(finding, textFragment, gitdiffFile))
+					for _, finding := range d.detect(fragment) {
+						finding = augmentGitFinding(finding, textFragment, gitdiffFile)
+						if rule, ok := d.Config.Rules[finding.RuleID]; ok &&
+							rule.Allowlist.AuthorAllowed(finding.Author, finding.Email) {
+							continue
+						}
+						finding.Message = truncateMessage(finding.Message, d.MaxCommitMessageLength)
+						if d.CheckActive && finding.Commit != "" {
+							finding.ActiveInHEAD = d.activeInHEAD(gitCmd.RepoPath(), finding)
+						}
+						d.addFinding(finding)
 					}
 				}
 				return nil
@@ -59,15 +93,62 @@ func (d *Detector) DetectGit(gitCmd *sources.GitCmd) ([]report.Finding, error) {
 				errCh = nil
 				break
 			}
+			// git complains when its output is cut off
+			if killed {
+				continue
+			}
 
-			return d.findings, err
+			return d.findings, d.addScanError(gitCmd.RepoPath(), report.ScanStageGit, err)
 		}
 	}
 
 	if err := d.Sema.Wait(); err != nil {
 		return d.findings, err
 	}
+	d.sortFindings()
 	log.Info().Msgf("%d commits scanned.", len(d.commitMap))
 	log.Debug().Msg("Note: this number might be smaller than expected due to commits with no additions")
 	return d.findings, nil
 }
+
+// commitMessageScanned reports whether a commit with the given message
+// should be scanned according to CommitMessageInclude and
+// CommitMessageExclude.
+func (d *Detector) commitMessageScanned(message string) bool {
+	if d.CommitMessageInclude != nil && !lineMatches(d.CommitMessageInclude, message) {
+		return false
+	}
+	if d.CommitMessageExclude != nil && lineMatches(d.CommitMessageExclude, message) {
+		return false
+	}
+	return true
+}
+
+// lineMatches reports whether re matches one of the lines of message.
+func lineMatches(re *regexp.Regexp, message string) bool {
+	for _, line := range strings.Split(message, "\n") {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// activeInHEAD reports whether the secret of a finding is still present in
+// the HEAD version of the file it was found in.
+func (d *Detector) activeInHEAD(repoPath string, finding report.Finding) bool {
+	d.headFilesMutex.Lock()
+	content, ok := d.headFiles[finding.File]
+	if !ok {
+		// the file may have been deleted or renamed since, in that
+		// case the secret is no longer present at HEAD
+		b, err := sources.ShowFile(repoPath, "HEAD", finding.File)
+		if err != nil {
+			log.Debug().Msgf("could not read %s at HEAD: %s", finding.File, err)
+		}
+		content = string(b)
+		d.headFiles[finding.File] = content
+	}
+	d.headFilesMutex.Unlock()
+	return finding.Secret != "" && strings.Contains(content, finding.Secret)
+}
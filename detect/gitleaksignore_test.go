@@ -0,0 +1,58 @@
+package detect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+func TestAddGitleaksIgnoreComments(t *testing.T) {
+	ignorePath := filepath.Join(t.TempDir(), ".gitleaksignore")
+	content := `# legacy findings accepted during the initial rollout
+main.go:aws-access-key:20
+
+   # indented comment
+  api.go:aws-access-key:3  
+`
+	require.NoError(t, os.WriteFile(ignorePath, []byte(content), 0644))
+
+	detector := NewDetector(config.Config{})
+	require.NoError(t, detector.AddGitleaksIgnore(ignorePath))
+	assert.Equal(t, map[string]bool{
+		"main.go:aws-access-key:20": true,
+		"api.go:aws-access-key:3":   true,
+	}, detector.gitleaksIgnore)
+
+	detector.addFinding(report.Finding{File: "main.go", RuleID: "aws-access-key", StartLine: 20})
+	detector.addFinding(report.Finding{File: "main.go", RuleID: "aws-access-key", StartLine: 21})
+	require.Len(t, detector.findings, 1)
+	assert.Equal(t, "main.go:aws-access-key:21", detector.findings[0].Fingerprint)
+}
+
+func TestWriteGitleaksIgnore(t *testing.T) {
+	ignorePath := filepath.Join(t.TempDir(), ".gitleaksignore")
+	require.NoError(t, os.WriteFile(ignorePath, []byte("# accepted\nmain.go:aws-access-key:20"), 0644))
+
+	findings := []report.Finding{
+		{Fingerprint: "main.go:aws-access-key:20"},
+		{Fingerprint: "abc123:api.go:github-pat:3"},
+		{Fingerprint: "abc123:api.go:github-pat:3"},
+	}
+	require.NoError(t, WriteGitleaksIgnore(findings, ignorePath))
+
+	got, err := os.ReadFile(ignorePath)
+	require.NoError(t, err)
+	assert.Equal(t, "# accepted\nmain.go:aws-access-key:20\nabc123:api.go:github-pat:3\n", string(got))
+
+	// the generated file ignores the findings it was generated from
+	detector := NewDetector(config.Config{})
+	require.NoError(t, detector.AddGitleaksIgnore(ignorePath))
+	detector.addFinding(report.Finding{Commit: "abc123", File: "api.go", RuleID: "github-pat", StartLine: 3})
+	assert.Empty(t, detector.findings)
+}
@@ -61,6 +61,7 @@ func location(fragment Fragment, matchIndex []int) Location {
 		location.endColumn = (end - prevNewLine)
 		location.startLine = _lineNum + 1
 		location.endLine = _lineNum + 1
+		location.startLineIndex = prevNewLine
 
 		// search for new line byte index
 		i := 0
@@ -2,13 +2,22 @@ package detect
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/zricethezav/gitleaks/v8/report"
 )
 
 // DetectReader accepts an io.Reader and a buffer size for the reader in KB
 func (d *Detector) DetectReader(r io.Reader, bufSize int) ([]report.Finding, error) {
+	return d.DetectReaderPath(r, bufSize, "")
+}
+
+// DetectReaderPath is like DetectReader but reports findings under filePath,
+// which lets path rules and path allowlists apply to content that is not read
+// from a file, such as stdin.
+func (d *Detector) DetectReaderPath(r io.Reader, bufSize int, filePath string) ([]report.Finding, error) {
 	reader := bufio.NewReader(r)
 	buf := make([]byte, 0, 1000*bufSize)
 	findings := []report.Finding{}
@@ -24,15 +33,73 @@ func (d *Detector) DetectReader(r io.Reader, bufSize int) ([]report.Finding, err
 		}
 
 		fragment := Fragment{
-			Raw: string(buf),
+			Raw:      string(buf),
+			FilePath: filePath,
+		}
+		findings = append(findings, d.Detect(fragment)...)
+	}
+
+	return findings, nil
+}
+
+// DetectLines scans r line by line and reports findings under filePath.
+// Whole lines are grouped into fragments of about chunkSize bytes, so
+// unlike DetectReader a secret is never split between two fragments, line
+// numbers are relative to the start of r, and memory use is bounded by
+// chunkSize and MaxLineBytes however large the input is.
+func (d *Detector) DetectLines(r io.Reader, filePath string) ([]report.Finding, error) {
+	maxLineBytes := d.MaxLineBytes
+	if maxLineBytes <= 0 {
+		maxLineBytes = defaultMaxLineBytes
+	}
+	// the scanner accepts tokens as large as its initial buffer
+	initialSize := bufio.MaxScanTokenSize
+	if maxLineBytes < initialSize {
+		initialSize = maxLineBytes
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, initialSize), maxLineBytes)
+
+	findings := []report.Finding{}
+	var (
+		chunk        strings.Builder
+		linesInChunk int
+		totalLines   int
+	)
+	flush := func() {
+		fragment := Fragment{
+			Raw:      chunk.String(),
+			FilePath: filePath,
 		}
-		for _, finding := range d.Detect(fragment) {
-			findings = append(findings, finding)
-			if d.Verbose {
-				printFinding(finding, d.NoColor)
+		for _, finding := range d.detect(fragment) {
+			// need to add 1 since line counting starts at 1
+			finding.StartLine += (totalLines - linesInChunk) + 1
+			finding.EndLine += (totalLines - linesInChunk) + 1
+			if finding, ok := d.addFinding(finding); ok {
+				findings = append(findings, finding)
 			}
 		}
+		chunk.Reset()
+		linesInChunk = 0
 	}
 
+	for scanner.Scan() {
+		chunk.Write(scanner.Bytes())
+		chunk.WriteByte('\n')
+		linesInChunk++
+		totalLines++
+		if chunk.Len() >= chunkSize {
+			flush()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if err == bufio.ErrTooLong {
+			return findings, fmt.Errorf("line %d is longer than %d bytes", totalLines+1, maxLineBytes)
+		}
+		return findings, err
+	}
+	if chunk.Len() > 0 {
+		flush()
+	}
 	return findings, nil
 }
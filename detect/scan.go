@@ -0,0 +1,60 @@
+package detect
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/semgroup"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+	"github.com/zricethezav/gitleaks/v8/report"
+	"github.com/zricethezav/gitleaks/v8/sources"
+)
+
+// ScanRepoPath scans the source at path using cfg and returns the findings.
+// If path is a git repository, including a bare clone, its full history is
+// scanned, otherwise the files under path are scanned as a plain directory.
+// This is the entry point for programs embedding gitleaks that do not need
+// to configure a Detector.
+func ScanRepoPath(ctx context.Context, cfg config.Config, path string) ([]report.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	d := NewDetector(cfg)
+	d.Sema = semgroup.NewGroup(ctx, 40)
+	if deadline, ok := ctx.Deadline(); ok {
+		d.Deadline = deadline
+	}
+
+	if _, err := os.Stat(filepath.Join(path, ".git")); err == nil || sources.IsBareRepo(path) {
+		gitCmd, err := sources.NewGitLogCmd(path, "")
+		if err != nil {
+			return nil, err
+		}
+		return d.DetectGit(gitCmd)
+	}
+
+	paths, err := sources.DirectoryTargets(path, d.Sema, d.FollowSymlinks)
+	if err != nil {
+		return nil, err
+	}
+	return d.DetectFiles(paths)
+}
+
+// ScanContent scans content as if it was read from the file at filePath and
+// returns the findings. filePath is used for path based rules and allowlists
+// and may be empty. Line numbers in the findings start at 1.
+func ScanContent(ctx context.Context, cfg config.Config, filePath, content string) []report.Finding {
+	if ctx.Err() != nil {
+		return nil
+	}
+	d := NewDetector(cfg)
+	for _, finding := range d.detect(Fragment{Raw: content, FilePath: filePath}) {
+		// need to add 1 since line counting starts at 1
+		finding.StartLine++
+		finding.EndLine++
+		d.addFinding(finding)
+	}
+	return d.findings
+}
@@ -0,0 +1,63 @@
+package detect
+
+import (
+	"regexp"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+// SecretTypeGeneric is used for findings whose rule does not map to a more
+// specific secret type.
+const SecretTypeGeneric = "generic"
+
+// secretTypes maps rule ids to a normalized secret type so downstream tooling
+// can route findings without parsing rule descriptions. Entries are checked in
+// order, so more specific patterns must come first.
+var secretTypes = []struct {
+	ruleID     *regexp.Regexp
+	secretType string
+}{
+	{regexp.MustCompile(`^private-key`), "private_key"},
+	{regexp.MustCompile(`(^|-)aws-access-(token|key)$`), "aws_access_key"},
+	{regexp.MustCompile(`web-?hook`), "webhook_url"},
+	{regexp.MustCompile(`^jwt`), "jwt"},
+	{regexp.MustCompile(`filename`), "sensitive_file"},
+	{regexp.MustCompile(`password`), "password"},
+	{regexp.MustCompile(`^generic-`), SecretTypeGeneric},
+	{regexp.MustCompile(`(client|access|api|key)-id$`), "client_id"},
+	{regexp.MustCompile(`(secret|secret-key|signing-key|encryption-key)$`), "secret_key"},
+	{regexp.MustCompile(`(api-key|api-token|key)$`), "api_key"},
+	{regexp.MustCompile(`(token|pat|ptt|rrt|oauth)$`), "access_token"},
+}
+
+// tagSecretTypes maps rule tags to a normalized secret type. Tags describe
+// what a rule finds better than its id, e.g. a private key rule with the id
+// "apkey", so they are checked first.
+var tagSecretTypes = []struct {
+	tag        *regexp.Regexp
+	secretType string
+}{
+	{regexp.MustCompile(`(?i)private-?key`), "private_key"},
+	{regexp.MustCompile(`(?i)^web-?hook`), "webhook_url"},
+	{regexp.MustCompile(`(?i)^jwt$`), "jwt"},
+	{regexp.MustCompile(`(?i)^filename$`), "sensitive_file"},
+	{regexp.MustCompile(`(?i)^password$`), "password"},
+}
+
+// secretType returns the normalized secret type for a rule, from its tags or
+// else its id.
+func secretType(rule config.Rule) string {
+	for _, st := range tagSecretTypes {
+		for _, tag := range rule.Tags {
+			if st.tag.MatchString(tag) {
+				return st.secretType
+			}
+		}
+	}
+	for _, st := range secretTypes {
+		if st.ruleID.MatchString(rule.RuleID) {
+			return st.secretType
+		}
+	}
+	return SecretTypeGeneric
+}
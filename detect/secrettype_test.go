@@ -0,0 +1,40 @@
+package detect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func TestSecretType(t *testing.T) {
+	tests := map[string]string{
+		"aws-access-token":          "aws_access_key",
+		"slack-bot-token":           "access_token",
+		"slack-user-token":          "access_token",
+		"slack-config-access-token": "access_token",
+		"slack-webhook-url":         "webhook_url",
+		"private-key":               "private_key",
+		"generic-api-key":           SecretTypeGeneric,
+		"github-pat":                "access_token",
+		"adobe-client-secret":       "secret_key",
+		"alibaba-access-key-id":     "client_id",
+		"heroku-api-key":            "api_key",
+		"sensitive-filename":        "sensitive_file",
+		"sidekiq-sensitive-url":     SecretTypeGeneric,
+	}
+	for ruleID, want := range tests {
+		assert.Equal(t, want, secretType(config.Rule{RuleID: ruleID}), ruleID)
+	}
+
+	// tags are checked before the id
+	tagged := map[string]config.Rule{
+		"private_key":    {RuleID: "apkey", Tags: []string{"key", "AsymmetricPrivateKey"}},
+		"sensitive_file": {RuleID: "env-file", Tags: []string{"filename"}},
+		"api_key":        {RuleID: "stripe-api-key", Tags: []string{"key", "Stripe"}},
+	}
+	for want, rule := range tagged {
+		assert.Equal(t, want, secretType(rule), rule.RuleID)
+	}
+}
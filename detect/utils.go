@@ -9,6 +9,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/zricethezav/gitleaks/v8/config"
 	"github.com/zricethezav/gitleaks/v8/report"
 
 	"github.com/gitleaks/go-gitdiff/gitdiff"
@@ -30,11 +31,37 @@ func augmentGitFinding(finding report.Finding, textFragment *gitdiff.TextFragmen
 			finding.Author = f.PatchHeader.Author.Name
 			finding.Email = f.PatchHeader.Author.Email
 		}
+		if f.PatchHeader.Committer != nil {
+			finding.Committer = f.PatchHeader.Committer.Name
+			finding.CommitterEmail = f.PatchHeader.Committer.Email
+		}
 		finding.Date = f.PatchHeader.AuthorDate.UTC().Format(time.RFC3339)
 	}
 	return finding
 }
 
+// copyTags returns a copy of the tags of a rule for a finding, so findings
+// can be changed by callers without changing the rule or other findings.
+func copyTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(tags)), tags...)
+}
+
+// truncateMessage shortens a commit message to at most max characters.
+// A max of 0 leaves the message untouched.
+func truncateMessage(message string, max int) string {
+	if max <= 0 {
+		return message
+	}
+	runes := []rune(message)
+	if len(runes) <= max {
+		return message
+	}
+	return string(runes[:max]) + "..."
+}
+
 // shannonEntropy calculates the entropy of data using the formula defined here:
 // https://en.wiktionary.org/wiki/Shannon_entropy
 // Another way to think about what this is doing is calculating the number of bits
@@ -59,8 +86,28 @@ func shannonEntropy(data string) (entropy float64) {
 	return entropy
 }
 
-// filter will dedupe and redact findings
-func filter(findings []report.Finding, redact uint) []report.Finding {
+// entropyThreshold returns the entropy s must exceed to satisfy rule. For
+// relative rules the threshold scales with the maximum entropy a string of
+// that length can have.
+func entropyThreshold(rule config.Rule, s string) float64 {
+	if !rule.EntropyRelative {
+		return rule.Entropy
+	}
+	return rule.Entropy * maxEntropy(s)
+}
+
+// maxEntropy is the shannon entropy of a string of the same length as s in
+// which every character is different.
+func maxEntropy(s string) float64 {
+	n := len(s)
+	if n == 0 {
+		return 0
+	}
+	return math.Log2(float64(n))
+}
+
+// filter will dedupe findings
+func filter(findings []report.Finding) []report.Finding {
 	var retFindings []report.Finding
 	for _, f := range findings {
 		include := true
@@ -81,9 +128,6 @@ func filter(findings []report.Finding, redact uint) []report.Finding {
 			}
 		}
 
-		if redact > 0 {
-			f.Redact(redact)
-		}
 		if include {
 			retFindings = append(retFindings, f)
 		}
@@ -158,6 +202,12 @@ func printFinding(f report.Finding, noColor bool) {
 
 	fmt.Printf("%-12s %s\n", "RuleID:", f.RuleID)
 	fmt.Printf("%-12s %f\n", "Entropy:", f.Entropy)
+	if f.Encoding != "" {
+		fmt.Printf("%-12s %s\n", "Encoding:", f.Encoding)
+	}
+	if f.Verified {
+		fmt.Printf("%-12s %t\n", "Verified:", f.Verified)
+	}
 	if f.File == "" {
 		fmt.Println("")
 		return
@@ -172,6 +222,9 @@ func printFinding(f report.Finding, noColor bool) {
 	fmt.Printf("%-12s %s\n", "Commit:", f.Commit)
 	fmt.Printf("%-12s %s\n", "Author:", f.Author)
 	fmt.Printf("%-12s %s\n", "Email:", f.Email)
+	if f.Committer != "" && (f.Committer != f.Author || f.CommitterEmail != f.Email) {
+		fmt.Printf("%-12s %s <%s>\n", "Committer:", f.Committer, f.CommitterEmail)
+	}
 	fmt.Printf("%-12s %s\n", "Date:", f.Date)
 	fmt.Printf("%-12s %s\n", "Fingerprint:", f.Fingerprint)
 	fmt.Println("")
@@ -0,0 +1,26 @@
+package detect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateMessage(t *testing.T) {
+	tests := map[string]struct {
+		message string
+		max     int
+		expect  string
+	}{
+		"no limit":     {message: "temporary creds for demo", max: 0, expect: "temporary creds for demo"},
+		"under limit":  {message: "short", max: 10, expect: "short"},
+		"over limit":   {message: "temporary creds for demo", max: 9, expect: "temporary..."},
+		"multi-byte":   {message: "äöüäöü", max: 3, expect: "äöü..."},
+		"exact length": {message: "exact", max: 5, expect: "exact"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, truncateMessage(test.message, test.max))
+		})
+	}
+}
@@ -7,56 +7,73 @@ import (
 	"strings"
 )
 
-// writeCsv writes the list of findings to a writeCloser.
-func writeCsv(f []Finding, w io.WriteCloser) error {
-	if len(f) == 0 {
-		return nil
-	}
-	defer w.Close()
-	cw := csv.NewWriter(w)
-	err := cw.Write([]string{"RuleID",
-		"Commit",
-		"File",
-		"SymlinkFile",
-		"Secret",
-		"Match",
-		"StartLine",
-		"EndLine",
-		"StartColumn",
-		"EndColumn",
-		"Author",
-		"Message",
-		"Date",
-		"Email",
-		"Fingerprint",
-		"Tags",
-	})
-	if err != nil {
-		return err
-	}
-	for _, f := range f {
-		err = cw.Write([]string{f.RuleID,
-			f.Commit,
-			f.File,
-			f.SymlinkFile,
-			f.Secret,
-			f.Match,
-			strconv.Itoa(f.StartLine),
-			strconv.Itoa(f.EndLine),
-			strconv.Itoa(f.StartColumn),
-			strconv.Itoa(f.EndColumn),
-			f.Author,
-			f.Message,
-			f.Date,
-			f.Email,
-			f.Fingerprint,
-			strings.Join(f.Tags, " "),
+// CSVWriter streams findings as csv rows. The header is written along with
+// the first finding so an empty scan produces an empty report.
+type CSVWriter struct {
+	cw            *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVWriter returns a Writer that writes findings to w as csv.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{cw: csv.NewWriter(w)}
+}
+
+// Write writes a finding as a csv row.
+func (c *CSVWriter) Write(f Finding) error {
+	if !c.headerWritten {
+		err := c.cw.Write([]string{"RuleID",
+			"Commit",
+			"File",
+			"SymlinkFile",
+			"Secret",
+			"Match",
+			"StartLine",
+			"EndLine",
+			"StartColumn",
+			"EndColumn",
+			"Author",
+			"Message",
+			"Date",
+			"Email",
+			"Fingerprint",
+			"Tags",
 		})
 		if err != nil {
 			return err
 		}
+		c.headerWritten = true
 	}
+	return c.cw.Write([]string{f.RuleID,
+		f.Commit,
+		f.File,
+		f.SymlinkFile,
+		f.Secret,
+		f.Match,
+		strconv.Itoa(f.StartLine),
+		strconv.Itoa(f.EndLine),
+		strconv.Itoa(f.StartColumn),
+		strconv.Itoa(f.EndColumn),
+		f.Author,
+		CommitSubject(f.Message),
+		f.Date,
+		f.Email,
+		f.Fingerprint,
+		strings.Join(f.Tags, " "),
+	})
+}
 
-	cw.Flush()
-	return cw.Error()
+// Close flushes any buffered rows.
+func (c *CSVWriter) Close() error {
+	c.cw.Flush()
+	return c.cw.Error()
+}
+
+// writeCsv writes the list of findings to a writeCloser.
+func writeCsv(f []Finding, w io.WriteCloser) error {
+	if len(f) == 0 {
+		return nil
+	}
+	defer w.Close()
+	return writeFindings(NewCSVWriter(w), f)
 }
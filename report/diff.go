@@ -0,0 +1,55 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Diff compares the findings of a scan with a baseline report. added holds
+// the findings that are not in the baseline and removed holds the baseline
+// findings that are no longer found. Findings are matched by fingerprint,
+// so their order in either report does not matter.
+func Diff(current []Finding, baseline []Finding) (added []Finding, removed []Finding) {
+	baselineKeys := make(map[string]bool, len(baseline))
+	for _, f := range baseline {
+		baselineKeys[fingerprint(f)] = true
+	}
+	currentKeys := make(map[string]bool, len(current))
+	for _, f := range current {
+		key := fingerprint(f)
+		currentKeys[key] = true
+		if !baselineKeys[key] {
+			added = append(added, f)
+		}
+	}
+	for _, f := range baseline {
+		if !currentKeys[fingerprint(f)] {
+			removed = append(removed, f)
+		}
+	}
+	return added, removed
+}
+
+// fingerprint returns the fingerprint of a finding, computing it the same
+// way the detector does for reports that were written without one.
+func fingerprint(f Finding) string {
+	if f.Fingerprint != "" {
+		return f.Fingerprint
+	}
+	if f.Commit != "" {
+		return fmt.Sprintf("%s:%s:%s:%d", f.Commit, f.File, f.RuleID, f.StartLine)
+	}
+	return fmt.Sprintf("%s:%s:%d", f.File, f.RuleID, f.StartLine)
+}
+
+// WriteJSONLines writes one json encoded finding per line.
+func WriteJSONLines(w io.Writer, findings []Finding) error {
+	encoder := json.NewEncoder(w)
+	for _, f := range findings {
+		if err := encoder.Encode(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
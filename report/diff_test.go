@@ -0,0 +1,47 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	unchanged := Finding{RuleID: "aws-access-token", File: "main.go", StartLine: 20, Fingerprint: "main.go:aws-access-token:20"}
+	resolved := Finding{RuleID: "github-pat", File: "api.go", StartLine: 3, Fingerprint: "api.go:github-pat:3"}
+	introduced := Finding{RuleID: "github-pat", File: "cli.go", StartLine: 7, Fingerprint: "cli.go:github-pat:7"}
+	// a baseline written without fingerprints still matches
+	legacy := Finding{RuleID: "private-key", File: "key.pem", StartLine: 1, Commit: "abc123"}
+	legacyCurrent := legacy
+	legacyCurrent.Fingerprint = "abc123:key.pem:private-key:1"
+
+	// the same findings in a different order are not reported as changed
+	current := []Finding{introduced, legacyCurrent, unchanged}
+	baseline := []Finding{unchanged, legacy, resolved}
+
+	added, removed := Diff(current, baseline)
+	assert.Equal(t, []Finding{introduced}, added)
+	assert.Equal(t, []Finding{resolved}, removed)
+
+	added, removed = Diff(baseline, baseline)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	findings := []Finding{{RuleID: "a"}, {RuleID: "b"}}
+	require.NoError(t, WriteJSONLines(&buf, findings))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		var f Finding
+		require.NoError(t, json.Unmarshal([]byte(line), &f))
+		assert.Equal(t, findings[i].RuleID, f.RuleID)
+	}
+}
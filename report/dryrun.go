@@ -0,0 +1,36 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// DryRun is the result of a dry-run scan. It holds the scan summary and
+// the number of findings per rule and per file, but no secrets, matches
+// or lines, so it can be shared with people who should not see findings.
+type DryRun struct {
+	Summary
+	Rules map[string]int `json:"rules"`
+	Files map[string]int `json:"files"`
+}
+
+// NewDryRun counts findings per rule and per file.
+func NewDryRun(findings []Finding, summary Summary) DryRun {
+	d := DryRun{
+		Summary: summary,
+		Rules:   make(map[string]int),
+		Files:   make(map[string]int),
+	}
+	for _, f := range findings {
+		d.Rules[f.RuleID]++
+		d.Files[f.File]++
+	}
+	return d
+}
+
+// WriteDryRun writes the dry-run result as json.
+func WriteDryRun(w io.Writer, d DryRun) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", " ")
+	return encoder.Encode(d)
+}
@@ -1,12 +1,17 @@
 package report
 
 import (
+	"fmt"
 	"math"
 	"strings"
 )
 
 // Finding contains information about strings that
 // have been captured by a tree-sitter query.
+//
+// Finding is part of the library API returned by detect.ScanRepoPath and
+// detect.ScanContent. Existing fields will not be removed or renamed within
+// a major version.
 type Finding struct {
 	Description string
 	StartLine   int
@@ -36,11 +41,51 @@ type Finding struct {
 	Message string
 	Tags    []string
 
+	// Committer and CommitterEmail identify who committed the change, which
+	// differs from the author for rebased or cherry-picked commits.
+	Committer      string `json:",omitempty"`
+	CommitterEmail string `json:",omitempty"`
+
 	// Rule is the name of the rule that was matched
 	RuleID string
 
+	// Severity is the severity of the rule, one of Severities, if the
+	// rule has one.
+	Severity string `json:",omitempty"`
+
+	// RemediationURL links to documentation on how to revoke or rotate
+	// the secret, if the rule has one.
+	RemediationURL string `json:",omitempty"`
+
+	// Confidence estimates how likely the finding is a real secret, from 0
+	// to 1, see Score.
+	Confidence float64 `json:",omitempty"`
+
+	// SecretType is a normalized kind of secret derived from the rule,
+	// e.g. "aws_access_key", "private_key" or "generic".
+	SecretType string `json:",omitempty"`
+
+	// Encoding is set when the secret was found in decoded content, e.g.
+	// "base64", or in joined string literals, "concatenation". The location
+	// of the finding is that of the encoded string or the concatenation.
+	Encoding string `json:",omitempty"`
+
+	// Encoded is the encoded string or the concatenation the secret was
+	// found in when Encoding is set. It is part of Line and is masked by
+	// Redact along with the secret.
+	Encoded string `json:"-"`
+
 	// unique identifier
 	Fingerprint string
+
+	// ActiveInHEAD is set when a secret found in git history is still
+	// present in the HEAD version of the file. Only populated when
+	// checking for active secrets is enabled.
+	ActiveInHEAD bool `json:",omitempty"`
+
+	// Verified is set when a verifier confirmed the secret is a live
+	// credential. Only populated when verification is enabled.
+	Verified bool `json:",omitempty"`
 }
 
 // Redact removes sensitive information from a finding.
@@ -52,6 +97,39 @@ func (f *Finding) Redact(percent uint) {
 	f.Line = strings.Replace(f.Line, f.Secret, secret, -1)
 	f.Match = strings.Replace(f.Match, f.Secret, secret, -1)
 	f.Secret = secret
+	// the secret can be decoded from the encoded string in the line
+	if f.Encoded != "" {
+		encoded := maskSecret(f.Encoded, percent)
+		if percent >= 100 {
+			encoded = "REDACTED"
+		}
+		f.Line = strings.Replace(f.Line, f.Encoded, encoded, -1)
+		f.Match = strings.Replace(f.Match, f.Encoded, encoded, -1)
+		f.Encoded = encoded
+	}
+}
+
+// ShortHash returns the abbreviated commit hash of the finding for display.
+// Commit always holds the full hash.
+func (f Finding) ShortHash() string {
+	if len(f.Commit) > shortHashLength {
+		return f.Commit[:shortHashLength]
+	}
+	return f.Commit
+}
+
+// shortHashLength is the length of abbreviated commit hashes, the default
+// of git.
+const shortHashLength = 7
+
+// String returns a one line summary of the finding for logs. The secret is
+// partially masked.
+func (f Finding) String() string {
+	s := fmt.Sprintf("%s at %s:%d: %s", f.RuleID, f.File, f.StartLine, displaySecret(f.Secret))
+	if f.Commit != "" {
+		s += " (commit " + f.ShortHash() + ")"
+	}
+	return s
 }
 
 func maskSecret(secret string, percent uint) string {
@@ -67,3 +145,12 @@ func maskSecret(secret string, percent uint) string {
 
 	return secret[:lth] + "..."
 }
+
+// CommitSubject returns the first line of a commit message. It is used by
+// compact report formats where multi-line messages are hard to read.
+func CommitSubject(message string) string {
+	if i := strings.IndexAny(message, "\r\n"); i != -1 {
+		return message[:i]
+	}
+	return message
+}
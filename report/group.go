@@ -0,0 +1,52 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// untaggedGroup is the section used for findings whose rule has no tags.
+const untaggedGroup = "untagged"
+
+// GroupByTag organizes findings into sections keyed by rule tag. A finding
+// produced by a rule with multiple tags appears under each of those tags.
+// Findings without any tags are grouped under "untagged".
+func GroupByTag(findings []Finding) map[string][]Finding {
+	groups := make(map[string][]Finding)
+	for _, f := range findings {
+		if len(f.Tags) == 0 {
+			groups[untaggedGroup] = append(groups[untaggedGroup], f)
+			continue
+		}
+		seen := make(map[string]bool, len(f.Tags))
+		for _, tag := range f.Tags {
+			// a rule listing the same tag twice should not duplicate the finding
+			if seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			groups[tag] = append(groups[tag], f)
+		}
+	}
+	return groups
+}
+
+// SortedTags returns the tags of the given groups in a deterministic order so
+// report sections are stable between runs.
+func SortedTags(groups map[string][]Finding) []string {
+	tags := make([]string, 0, len(groups))
+	for tag := range groups {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// writeJsonGroupedByTag writes findings as a json object keyed by tag.
+func writeJsonGroupedByTag(findings []Finding, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", " ")
+	// encoding/json sorts map keys, which keeps the tag sections ordered
+	return encoder.Encode(GroupByTag(findings))
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByTag(t *testing.T) {
+	awsKey := Finding{RuleID: "aws-access-token", Tags: []string{"aws", "key"}}
+	githubPat := Finding{RuleID: "github-pat", Tags: []string{"github"}}
+	duplicateTags := Finding{RuleID: "dup", Tags: []string{"aws", "aws"}}
+	noTags := Finding{RuleID: "no-tags", Tags: []string{}}
+
+	groups := GroupByTag([]Finding{awsKey, githubPat, duplicateTags, noTags})
+
+	assert.Equal(t, []Finding{awsKey, duplicateTags}, groups["aws"])
+	assert.Equal(t, []Finding{awsKey}, groups["key"])
+	assert.Equal(t, []Finding{githubPat}, groups["github"])
+	assert.Equal(t, []Finding{noTags}, groups[untaggedGroup])
+	assert.Equal(t, []string{"aws", "github", "key", untaggedGroup}, SortedTags(groups))
+}
+
+func TestWriteGroupedByTag(t *testing.T) {
+	findings := []Finding{
+		{RuleID: "github-pat", Tags: []string{"github"}},
+		{RuleID: "aws-access-token", Tags: []string{"aws", "github"}},
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "grouped.json")
+	require.NoError(t, WriteGroupedByTag(findings, "json", reportPath))
+
+	got, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	var groups map[string][]Finding
+	require.NoError(t, json.Unmarshal(got, &groups))
+	assert.Len(t, groups["aws"], 1)
+	assert.Len(t, groups["github"], 2)
+
+	err = WriteGroupedByTag(findings, "csv", filepath.Join(t.TempDir(), "grouped.csv"))
+	assert.Error(t, err)
+}
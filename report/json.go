@@ -5,13 +5,49 @@ import (
 	"io"
 )
 
-func writeJson(findings []Finding, w io.WriteCloser) error {
-	if len(findings) == 0 {
-		findings = []Finding{}
+// JSONWriter streams findings as a json array.
+type JSONWriter struct {
+	w     io.Writer
+	count int
+}
+
+// NewJSONWriter returns a Writer that writes findings to w as a json array.
+func NewJSONWriter(w io.Writer) *JSONWriter {
+	return &JSONWriter{w: w}
+}
+
+// Write appends a finding to the json array.
+func (jw *JSONWriter) Write(f Finding) error {
+	data, err := json.MarshalIndent(f, " ", " ")
+	if err != nil {
+		return err
 	}
-	defer w.Close()
+	sep := "[\n "
+	if jw.count > 0 {
+		sep = ",\n "
+	}
+	if _, err = io.WriteString(jw.w, sep); err != nil {
+		return err
+	}
+	if _, err = jw.w.Write(data); err != nil {
+		return err
+	}
+	jw.count++
+	return nil
+}
 
-	encoder := json.NewEncoder(w)
-	encoder.SetIndent("", " ")
-	return encoder.Encode(findings)
+// Close terminates the json array. An empty array is written if no findings
+// were written.
+func (jw *JSONWriter) Close() error {
+	end := "\n]\n"
+	if jw.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(jw.w, end)
+	return err
+}
+
+func writeJson(findings []Finding, w io.WriteCloser) error {
+	defer w.Close()
+	return writeFindings(NewJSONWriter(w), findings)
 }
@@ -1,8 +1,10 @@
 package report
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,3 +68,34 @@ func TestWriteJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRemediationURL(t *testing.T) {
+	findings := []Finding{
+		{
+			RuleID:         "mailgun-private-api-token",
+			File:           "mail.py",
+			Secret:         "key-0123456789abcdef0123456789abcdef",
+			Tags:           []string{},
+			RemediationURL: "https://app.mailgun.com/settings/api_security",
+		},
+		{
+			RuleID: "generic-api-key",
+			File:   "config.env",
+			Secret: "abc",
+			Tags:   []string{},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	tmpfile, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, writeJson(findings, tmpfile))
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var decoded []Finding
+	require.NoError(t, json.Unmarshal(got, &decoded))
+	assert.Equal(t, findings, decoded)
+	// findings of rules without a remediation url don't have the key
+	assert.Equal(t, 1, strings.Count(string(got), `"RemediationURL"`))
+}
@@ -8,17 +8,42 @@ import (
 	"strconv"
 )
 
-func writeJunit(findings []Finding, w io.WriteCloser) error {
+// JunitWriter collects findings and writes them as a junit xml document
+// when closed.
+type JunitWriter struct {
+	w        io.Writer
+	findings []Finding
+}
+
+// NewJunitWriter returns a Writer that writes findings to w as junit xml.
+func NewJunitWriter(w io.Writer) *JunitWriter {
+	return &JunitWriter{w: w}
+}
+
+// Write adds a finding as a failed test case.
+func (j *JunitWriter) Write(f Finding) error {
+	j.findings = append(j.findings, f)
+	return nil
+}
+
+// Close writes the junit xml document.
+func (j *JunitWriter) Close() error {
 	testSuites := TestSuites{
-		TestSuites: getTestSuites(findings),
+		TestSuites: getTestSuites(j.findings),
 	}
 
-	io.WriteString(w, xml.Header)
-	encoder := xml.NewEncoder(w)
+	if _, err := io.WriteString(j.w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(j.w)
 	encoder.Indent("", "\t")
 	return encoder.Encode(testSuites)
 }
 
+func writeJunit(findings []Finding, w io.WriteCloser) error {
+	return writeFindings(NewJunitWriter(w), findings)
+}
+
 func getTestSuites(findings []Finding) []TestSuite {
 	return []TestSuite{
 		{
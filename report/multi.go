@@ -0,0 +1,109 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+// ReportSpec is a report destination, given on the command line as
+// format:path, e.g. sarif:results.sarif. A path of - is stdout.
+type ReportSpec struct {
+	Format string
+	Path   string
+}
+
+func (s ReportSpec) String() string {
+	return s.Format + ":" + s.Path
+}
+
+// ParseReportSpec parses a format:path report destination.
+func ParseReportSpec(spec string) (ReportSpec, error) {
+	format, path, ok := strings.Cut(spec, ":")
+	if !ok || path == "" {
+		return ReportSpec{}, fmt.Errorf("invalid report %q, must be format:path", spec)
+	}
+	format = strings.ToLower(format)
+	if newWriter(format, config.Config{}, io.Discard) == nil {
+		return ReportSpec{}, fmt.Errorf("invalid report %q, unknown format %s", spec, format)
+	}
+	return ReportSpec{Format: format, Path: path}, nil
+}
+
+// WriteReports writes the findings to every report. A report that can not
+// be written does not stop the others, its error is returned once all
+// reports have been written.
+func WriteReports(findings []Finding, cfg config.Config, specs []ReportSpec) error {
+	var (
+		m    multiWriter
+		errs []string
+	)
+	for _, spec := range specs {
+		file := os.Stdout
+		if spec.Path != "-" {
+			var err error
+			if file, err = os.Create(spec.Path); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", spec, err))
+				continue
+			}
+			defer file.Close()
+		}
+		m.add(spec, newWriter(spec.Format, cfg, file))
+	}
+
+	if err := writeFindings(&m, findings); err != nil {
+		return err
+	}
+	errs = append(errs, m.errs...)
+	if len(errs) > 0 {
+		return fmt.Errorf("could not write %d of %d reports: %s", len(errs), len(specs), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// multiWriter fans findings out to several Writers. A Writer that fails is
+// dropped and its error recorded, the others keep receiving findings.
+type multiWriter struct {
+	specs   []ReportSpec
+	writers []Writer
+	errs    []string
+}
+
+func (m *multiWriter) add(spec ReportSpec, w Writer) {
+	m.specs = append(m.specs, spec)
+	m.writers = append(m.writers, w)
+}
+
+// Write writes a finding to every Writer that has not failed yet.
+func (m *multiWriter) Write(f Finding) error {
+	for i, w := range m.writers {
+		if w == nil {
+			continue
+		}
+		if err := w.Write(f); err != nil {
+			m.fail(i, err)
+		}
+	}
+	return nil
+}
+
+// Close closes every Writer that has not failed yet.
+func (m *multiWriter) Close() error {
+	for i, w := range m.writers {
+		if w == nil {
+			continue
+		}
+		if err := w.Close(); err != nil {
+			m.fail(i, err)
+		}
+	}
+	return nil
+}
+
+func (m *multiWriter) fail(i int, err error) {
+	m.errs = append(m.errs, fmt.Sprintf("%s: %s", m.specs[i], err))
+	m.writers[i] = nil
+}
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func TestParseReportSpec(t *testing.T) {
+	spec, err := ParseReportSpec("SARIF:out/results.sarif")
+	require.NoError(t, err)
+	assert.Equal(t, ReportSpec{Format: "sarif", Path: "out/results.sarif"}, spec)
+
+	_, err = ParseReportSpec("report.json")
+	assert.EqualError(t, err, `invalid report "report.json", must be format:path`)
+	_, err = ParseReportSpec("json:")
+	assert.EqualError(t, err, `invalid report "json:", must be format:path`)
+	_, err = ParseReportSpec("xml2:report.xml")
+	assert.EqualError(t, err, `invalid report "xml2:report.xml", unknown format xml2`)
+}
+
+func TestWriteReports(t *testing.T) {
+	findings := []Finding{
+		{RuleID: "test-rule", File: "auth.py", Secret: "a secret", Tags: []string{}},
+		{RuleID: "another-rule", File: "main.go", Secret: "another secret", Tags: []string{}},
+	}
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "report.json")
+	csvPath := filepath.Join(dir, "report.csv")
+	unwritable := filepath.Join(dir, "missing", "report.sarif")
+
+	err := WriteReports(findings, config.Config{}, []ReportSpec{
+		{Format: "json", Path: jsonPath},
+		{Format: "sarif", Path: unwritable},
+		{Format: "csv", Path: csvPath},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not write 1 of 3 reports: sarif:"+unwritable)
+
+	// the other reports are written in full
+	data, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+	var got []Finding
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, findings, got)
+
+	f, err := os.Open(csvPath)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, records, 3)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestMultiWriterFailure(t *testing.T) {
+	var buf bytes.Buffer
+	var m multiWriter
+	m.add(ReportSpec{Format: "csv", Path: "a.csv"}, NewCSVWriter(failingWriter{}))
+	m.add(ReportSpec{Format: "pretty", Path: "-"}, NewPrettyWriter(&buf, false))
+
+	require.NoError(t, writeFindings(&m, []Finding{{RuleID: "test-rule"}, {RuleID: "another-rule"}}))
+	assert.Equal(t, []string{"csv:a.csv: disk full"}, m.errs)
+	assert.Contains(t, buf.String(), "test-rule")
+	assert.Contains(t, buf.String(), "another-rule")
+}
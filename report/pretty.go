@@ -0,0 +1,113 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ANSI escape codes used by PrettyWriter.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+	ansiGray   = "\x1b[90m"
+)
+
+// PrettyWriter writes findings as aligned blocks for reading in a terminal.
+// Secrets are partially masked, so the output can be shared in CI logs.
+type PrettyWriter struct {
+	w     io.Writer
+	color bool
+}
+
+// NewPrettyWriter returns a Writer that writes findings to w as text,
+// colored with ANSI escape codes if color is set.
+func NewPrettyWriter(w io.Writer, color bool) *PrettyWriter {
+	return &PrettyWriter{w: w, color: color}
+}
+
+// WritePretty writes findings to w as text, see PrettyWriter.
+func WritePretty(findings []Finding, w io.Writer, color bool) error {
+	return writeFindings(NewPrettyWriter(w, color), findings)
+}
+
+// ColorEnabled reports whether output to f should be colored: f must be a
+// terminal and the NO_COLOR environment variable (https://no-color.org)
+// must not be set.
+func ColorEnabled(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Write writes a finding as a block of aligned fields followed by an empty
+// line.
+func (p *PrettyWriter) Write(f Finding) error {
+	var b strings.Builder
+	b.WriteString(p.style(ansiBold+ansiRed, f.RuleID))
+	if f.Severity != "" {
+		b.WriteString(" " + p.style(severityColor(f.Severity), "["+f.Severity+"]"))
+	}
+	b.WriteString("\n")
+	if f.Description != "" {
+		p.field(&b, "", p.style(ansiGray, f.Description))
+	}
+	location := f.File
+	if location == "" {
+		location = "-"
+	}
+	p.field(&b, "File:", p.style(ansiCyan, fmt.Sprintf("%s:%d", location, f.StartLine)))
+	p.field(&b, "Secret:", p.style(ansiYellow, displaySecret(f.Secret)))
+	if f.RemediationURL != "" {
+		p.field(&b, "Fix:", f.RemediationURL)
+	}
+	if f.Commit != "" {
+		p.field(&b, "Commit:", fmt.Sprintf("%s %s", f.ShortHash(), p.style(ansiGray, fmt.Sprintf("%s <%s> %s", f.Author, f.Email, f.Date))))
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(p.w, b.String())
+	return err
+}
+
+// Close does nothing, findings are written as they arrive.
+func (p *PrettyWriter) Close() error {
+	return nil
+}
+
+func (p *PrettyWriter) field(b *strings.Builder, name, value string) {
+	fmt.Fprintf(b, "  %-8s %s\n", name, value)
+}
+
+func (p *PrettyWriter) style(code, s string) string {
+	if !p.color {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+func severityColor(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "high":
+		return ansiBold + ansiRed
+	case "medium":
+		return ansiYellow
+	}
+	return ansiGray
+}
+
+// displaySecret masks the second half of a secret that is not redacted yet.
+func displaySecret(secret string) string {
+	if secret == "REDACTED" || strings.HasSuffix(secret, "...") {
+		return secret
+	}
+	return maskSecret(secret, 50)
+}
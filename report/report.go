@@ -1,6 +1,7 @@
 package report
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -14,21 +15,38 @@ const (
 )
 
 func Write(findings []Finding, cfg config.Config, ext string, reportPath string) error {
-	file, err := os.Create(reportPath)
-	if err != nil {
-		return err
+	file := os.Stdout
+	if reportPath != "-" {
+		var err error
+		file, err = os.Create(reportPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
 	}
-	ext = strings.ToLower(ext)
-	switch ext {
-	case ".json", "json":
-		err = writeJson(findings, file)
-	case ".csv", "csv":
-		err = writeCsv(findings, file)
-	case ".xml", "junit":
-		err = writeJunit(findings, file)
-	case ".sarif", "sarif":
-		err = writeSarif(cfg, findings, file)
+
+	w := newWriter(strings.ToLower(ext), cfg, file)
+	if w == nil {
+		return nil
 	}
+	return writeFindings(w, findings)
+}
 
-	return err
+// WriteGroupedByTag writes a report with findings organized into sections by
+// rule tag. Only the json format supports grouping.
+func WriteGroupedByTag(findings []Finding, ext string, reportPath string) error {
+	ext = strings.ToLower(ext)
+	if ext != "json" && ext != ".json" {
+		return fmt.Errorf("grouping by tag is not supported for report format %s", ext)
+	}
+	file := os.Stdout
+	if reportPath != "-" {
+		var err error
+		file, err = os.Create(reportPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+	}
+	return writeJsonGroupedByTag(findings, file)
 }
@@ -8,19 +8,44 @@ import (
 	"github.com/zricethezav/gitleaks/v8/config"
 )
 
-func writeSarif(cfg config.Config, findings []Finding, w io.WriteCloser) error {
+// SarifWriter collects findings and writes them as a single sarif document
+// when closed, since a sarif run can not be streamed incrementally.
+type SarifWriter struct {
+	w        io.Writer
+	cfg      config.Config
+	findings []Finding
+}
+
+// NewSarifWriter returns a Writer that writes findings to w in the sarif
+// format. The config is used to describe the rules of the run.
+func NewSarifWriter(w io.Writer, cfg config.Config) *SarifWriter {
+	return &SarifWriter{w: w, cfg: cfg}
+}
+
+// Write adds a finding to the sarif results.
+func (s *SarifWriter) Write(f Finding) error {
+	s.findings = append(s.findings, f)
+	return nil
+}
+
+// Close writes the sarif document.
+func (s *SarifWriter) Close() error {
 	sarif := Sarif{
 		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
 		Version: "2.1.0",
-		Runs:    getRuns(cfg, findings),
+		Runs:    getRuns(s.cfg, s.findings),
 	}
-	defer w.Close()
 
-	encoder := json.NewEncoder(w)
+	encoder := json.NewEncoder(s.w)
 	encoder.SetIndent("", " ")
 	return encoder.Encode(sarif)
 }
 
+func writeSarif(cfg config.Config, findings []Finding, w io.WriteCloser) error {
+	defer w.Close()
+	return writeFindings(NewSarifWriter(w, cfg), findings)
+}
+
 func getRuns(cfg config.Config, findings []Finding) []Runs {
 	return []Runs{
 		{
@@ -72,6 +97,7 @@ func getRules(cfg config.Config) []Rules {
 			ID:          rule.RuleID,
 			Name:        rule.Description,
 			Description: shortDescription,
+			HelpUri:     rule.RemediationURL,
 		})
 	}
 	return rules
@@ -98,14 +124,17 @@ func getResults(findings []Finding) []Results {
 			// This information goes in partial fingerprings until revision
 			// data can be added somewhere else
 			PartialFingerPrints: PartialFingerPrints{
-				CommitSha:     f.Commit,
-				Email:         f.Email,
-				CommitMessage: f.Message,
-				Date:          f.Date,
-				Author:        f.Author,
+				CommitSha:      f.Commit,
+				Email:          f.Email,
+				CommitMessage:  f.Message,
+				Date:           f.Date,
+				Author:         f.Author,
+				Committer:      f.Committer,
+				CommitterEmail: f.CommitterEmail,
 			},
 			Properties: Properties{
-				Tags: f.Tags,
+				Tags:       f.Tags,
+				SecretType: f.SecretType,
 			},
 		}
 		results = append(results, r)
@@ -144,6 +173,9 @@ type PartialFingerPrints struct {
 	Author        string `json:"author"`
 	Date          string `json:"date"`
 	CommitMessage string `json:"commitMessage"`
+	// Committer is only set when scanning git history
+	Committer      string `json:"committer,omitempty"`
+	CommitterEmail string `json:"committerEmail,omitempty"`
 }
 
 type Sarif struct {
@@ -164,6 +196,7 @@ type Rules struct {
 	ID          string           `json:"id"`
 	Name        string           `json:"name"`
 	Description ShortDescription `json:"shortDescription"`
+	HelpUri     string           `json:"helpUri,omitempty"`
 }
 
 type Driver struct {
@@ -207,7 +240,8 @@ type Locations struct {
 }
 
 type Properties struct {
-	Tags []string `json:"tags"`
+	Tags       []string `json:"tags"`
+	SecretType string   `json:"secretType,omitempty"`
 }
 
 type Results struct {
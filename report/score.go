@@ -0,0 +1,134 @@
+package report
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+const (
+	// confidentEntropy is the shannon entropy at which a secret looks
+	// fully random, e.g. a 40 character base62 token.
+	confidentEntropy = 4.5
+
+	// keywordDistance is how many characters a keyword may be away from
+	// the secret to count as near it, e.g. `api_key = "<secret>"`.
+	keywordDistance = 30
+
+	// placeholderPenalty scales the confidence of secrets that contain a
+	// placeholder word.
+	placeholderPenalty = 0.3
+)
+
+// placeholderWords are words found in example and dummy secrets. They only
+// count as whole words, so "test" matches "my_test_key" but not "latest".
+var placeholderWords = []string{
+	"example",
+	"sample",
+	"dummy",
+	"placeholder",
+	"changeme",
+	"your",
+	"test",
+	"fake",
+	"xxxx",
+	"1234",
+}
+
+// Score estimates how likely it is that a finding of rule is a real secret,
+// from 0 to 1. It is the weighted sum of:
+//
+//   - 0.6 × the entropy of the secret, relative to 4.5 and capped at 1
+//   - 0.4 × 1 if a keyword of the rule is within 30 characters of the
+//     secret, 0.5 if it is elsewhere on the line and 0 otherwise. Rules
+//     without keywords are specific enough to always count as 1.
+//
+// The sum is multiplied by 0.3 if the secret contains a stop word of the
+// rule or a placeholder word such as "example" or "changeme" as a whole
+// word.
+func Score(f Finding, rule config.Rule) float64 {
+	entropyScore := math.Min(float64(f.Entropy)/confidentEntropy, 1)
+	score := 0.6*entropyScore + 0.4*keywordScore(f, rule)
+	if isPlaceholder(f.Secret, rule) {
+		score *= placeholderPenalty
+	}
+	return math.Round(score*100) / 100
+}
+
+// keywordScore rates how close a keyword of the rule is to the secret.
+func keywordScore(f Finding, rule config.Rule) float64 {
+	keywords := append(append([]string{}, rule.Keywords...), rule.KeywordsAll...)
+	if len(keywords) == 0 {
+		return 1
+	}
+	line := strings.ToLower(f.Line)
+	start := strings.Index(line, strings.ToLower(f.Secret))
+	if f.Secret == "" || start == -1 {
+		start = 0
+	}
+	end := start + len(f.Secret)
+
+	score := 0.0
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(keyword)
+		for offset := 0; ; {
+			i := strings.Index(line[offset:], keyword)
+			if i == -1 {
+				break
+			}
+			i += offset
+			if i+len(keyword) >= start-keywordDistance && i <= end+keywordDistance {
+				return 1
+			}
+			score = 0.5
+			offset = i + len(keyword)
+		}
+	}
+	return score
+}
+
+// isPlaceholder reports whether the secret contains a stop word of the rule
+// or a placeholder word.
+func isPlaceholder(secret string, rule config.Rule) bool {
+	if rule.Allowlist.ContainsStopWord(secret) {
+		return true
+	}
+	for _, word := range words(secret) {
+		for _, placeholder := range placeholderWords {
+			if word == placeholder {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// words splits s into lower cased words at punctuation, between letters and
+// digits and before an upper case letter that follows a lower case one, e.g.
+// "yourApiKey_1234" into "your", "api", "key" and "1234".
+func words(s string) []string {
+	var (
+		words []string
+		word  []rune
+		prev  rune
+	)
+	for _, r := range s {
+		boundary := !unicode.IsLetter(r) && !unicode.IsDigit(r) ||
+			unicode.IsLetter(r) != unicode.IsLetter(prev) ||
+			unicode.IsUpper(r) && unicode.IsLower(prev)
+		if boundary && len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word = append(word, r)
+		}
+		prev = r
+	}
+	if len(word) > 0 {
+		words = append(words, strings.ToLower(string(word)))
+	}
+	return words
+}
@@ -0,0 +1,21 @@
+package report
+
+import "github.com/zricethezav/gitleaks/v8/config"
+
+// MeetsSeverity reports whether any finding is at or above the threshold
+// severity. Findings of rules without a severity always count, so a
+// threshold never hides a leak that has not been classified. An empty
+// threshold is met by any finding.
+func MeetsSeverity(findings []Finding, threshold string) bool {
+	if threshold == "" {
+		return len(findings) > 0
+	}
+	thresholdRank := config.SeverityRank(threshold)
+	for _, f := range findings {
+		rank := config.SeverityRank(f.Severity)
+		if rank == -1 || rank >= thresholdRank {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeetsSeverity(t *testing.T) {
+	low := Finding{RuleID: "low", Severity: "low"}
+	high := Finding{RuleID: "high", Severity: "high"}
+	critical := Finding{RuleID: "critical", Severity: "CRITICAL"}
+	unclassified := Finding{RuleID: "unclassified"}
+
+	tests := map[string]struct {
+		threshold string
+		findings  []Finding
+		want      bool
+	}{
+		"no threshold, no findings": {
+			findings: []Finding{},
+		},
+		"no threshold, low finding": {
+			findings: []Finding{low},
+			want:     true,
+		},
+		"high threshold, low finding": {
+			threshold: "high",
+			findings:  []Finding{low},
+		},
+		"high threshold, high finding": {
+			threshold: "high",
+			findings:  []Finding{low, high},
+			want:      true,
+		},
+		"high threshold, critical finding": {
+			threshold: "high",
+			findings:  []Finding{critical},
+			want:      true,
+		},
+		"critical threshold, high finding": {
+			threshold: "critical",
+			findings:  []Finding{low, high},
+		},
+		"critical threshold, unclassified finding": {
+			threshold: "critical",
+			findings:  []Finding{unclassified},
+			want:      true,
+		},
+		"low threshold, no findings": {
+			threshold: "low",
+			findings:  []Finding{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MeetsSeverity(tt.findings, tt.threshold))
+		})
+	}
+}
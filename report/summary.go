@@ -0,0 +1,109 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Exit reasons reported in a Summary.
+const (
+	ExitReasonLeaks   = "leaks"
+	ExitReasonNoLeaks = "no-leaks"
+	ExitReasonError   = "error"
+	// ExitReasonBelowSeverity means leaks were found, but none at or above
+	// the severity that fails the scan.
+	ExitReasonBelowSeverity = "below-severity"
+	// ExitReasonTimeout means the scan stopped at --timeout, the findings
+	// cover only the content scanned by then.
+	ExitReasonTimeout = "timeout"
+)
+
+// Stages of a scan reported in a ScanError.
+const (
+	// ScanStageRead means a file or archive entry could not be read.
+	ScanStageRead = "read"
+	// ScanStageGit means git reported an error.
+	ScanStageGit = "git"
+	// ScanStageVerify means a secret could not be verified.
+	ScanStageVerify = "verify"
+)
+
+// ScanError is an error that did not stop the scan, such as a file that
+// could not be read. It records what failed so callers can inspect and
+// count the errors instead of reading them from the log.
+type ScanError struct {
+	// Source is the file, repository or finding fingerprint the error
+	// happened for.
+	Source string
+	// Stage is the part of the scan that failed, one of the ScanStage
+	// constants.
+	Stage string
+	Err   error
+}
+
+func (e ScanError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Stage, e.Source, e.Err)
+}
+
+func (e ScanError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON writes the error as its message.
+func (e ScanError) MarshalJSON() ([]byte, error) {
+	msg := ""
+	if e.Err != nil {
+		msg = e.Err.Error()
+	}
+	return json.Marshal(struct {
+		Source string `json:"source"`
+		Stage  string `json:"stage"`
+		Err    string `json:"error"`
+	}{e.Source, e.Stage, msg})
+}
+
+// Metrics describes how much content a scan processed and how long it
+// took.
+type Metrics struct {
+	ScannedCommits int   `json:"scannedCommits"`
+	ScannedFiles   int   `json:"scannedFiles"`
+	ScannedBytes   int64 `json:"scannedBytes"`
+	RulesEvaluated int64 `json:"rulesEvaluated"`
+	DurationMs     int64 `json:"durationMs"`
+	// BudgetExceeded means the scan stopped early because the total scan
+	// size limit was reached.
+	BudgetExceeded bool `json:"budgetExceeded,omitempty"`
+	// TimedOut means the scan stopped early because --timeout passed.
+	TimedOut bool `json:"timedOut,omitempty"`
+}
+
+// Summary describes the outcome of a scan. It is written even when no
+// leaks were found so automation can tell a clean scan apart from a scan
+// that did not run.
+type Summary struct {
+	Findings int `json:"findings"`
+	Metrics
+	ExitCode   int    `json:"exitCode"`
+	ExitReason string `json:"exitReason"`
+	// Errors are the errors that did not stop the scan.
+	Errors []ScanError `json:"errors,omitempty"`
+}
+
+// NewSummary returns the summary of a scan that started at start and ends
+// with exitCode.
+func NewSummary(findings []Finding, metrics Metrics, start time.Time, exitCode int, exitReason string) Summary {
+	metrics.DurationMs = time.Since(start).Milliseconds()
+	return Summary{
+		Findings:   len(findings),
+		Metrics:    metrics,
+		ExitCode:   exitCode,
+		ExitReason: exitReason,
+	}
+}
+
+// WriteSummary writes the summary as a single line of json.
+func WriteSummary(w io.Writer, s Summary) error {
+	return json.NewEncoder(w).Encode(s)
+}
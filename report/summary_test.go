@@ -0,0 +1,49 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSummaryCleanScan(t *testing.T) {
+	var buf bytes.Buffer
+	metrics := Metrics{ScannedCommits: 3, ScannedFiles: 12, ScannedBytes: 2048, RulesEvaluated: 1800}
+	summary := NewSummary([]Finding{}, metrics, time.Now(), 0, ExitReasonNoLeaks)
+	require.NoError(t, WriteSummary(&buf, summary))
+
+	// the summary is a single json object on the last line
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 1)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	assert.Equal(t, float64(0), got["findings"])
+	assert.Equal(t, float64(3), got["scannedCommits"])
+	assert.Equal(t, float64(12), got["scannedFiles"])
+	assert.Equal(t, float64(2048), got["scannedBytes"])
+	assert.Equal(t, float64(1800), got["rulesEvaluated"])
+	assert.Equal(t, float64(0), got["exitCode"])
+	assert.Equal(t, "no-leaks", got["exitReason"])
+	assert.Contains(t, got, "durationMs")
+}
+
+func TestWriteSummaryErrors(t *testing.T) {
+	var buf bytes.Buffer
+	summary := NewSummary([]Finding{}, Metrics{}, time.Now(), 1, ExitReasonError)
+	summary.Errors = []ScanError{{Source: "config/secrets.yml", Stage: ScanStageRead, Err: errors.New("permission denied")}}
+	require.NoError(t, WriteSummary(&buf, summary))
+	assert.Contains(t, buf.String(), `"errors":[{"source":"config/secrets.yml","stage":"read","error":"permission denied"}]`)
+	assert.Equal(t, "read config/secrets.yml: permission denied", summary.Errors[0].Error())
+
+	// clean scans have no errors key
+	buf.Reset()
+	require.NoError(t, WriteSummary(&buf, NewSummary([]Finding{}, Metrics{}, time.Now(), 0, ExitReasonNoLeaks)))
+	assert.NotContains(t, buf.String(), "errors")
+}
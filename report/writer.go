@@ -0,0 +1,46 @@
+package report
+
+import (
+	"io"
+	"os"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+// Writer streams findings to a report destination. Write is called once for
+// every finding and Close finalizes the report, e.g. by writing a closing
+// bracket or flushing buffered output. Close does not close the underlying
+// io.Writer, that is left to the caller.
+type Writer interface {
+	Write(f Finding) error
+	Close() error
+}
+
+// newWriter returns the Writer for the given report format or nil if the
+// format is unknown.
+func newWriter(ext string, cfg config.Config, w io.Writer) Writer {
+	switch ext {
+	case ".json", "json":
+		return NewJSONWriter(w)
+	case ".csv", "csv":
+		return NewCSVWriter(w)
+	case ".xml", "junit":
+		return NewJunitWriter(w)
+	case ".sarif", "sarif":
+		return NewSarifWriter(w, cfg)
+	case ".txt", "pretty":
+		f, ok := w.(*os.File)
+		return NewPrettyWriter(w, ok && ColorEnabled(f))
+	}
+	return nil
+}
+
+// writeFindings writes every finding to w and closes it.
+func writeFindings(w Writer, findings []Finding) error {
+	for _, f := range findings {
+		if err := w.Write(f); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
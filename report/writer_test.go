@@ -0,0 +1,55 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zricethezav/gitleaks/v8/config"
+)
+
+func TestWriters(t *testing.T) {
+	findings := []Finding{
+		{RuleID: "test-rule", File: "auth.py", Secret: "a secret", Tags: []string{}},
+		{RuleID: "another-rule", File: "main.go", Secret: "another secret", Tags: []string{}},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeFindings(NewJSONWriter(&buf), findings))
+		var got []Finding
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, findings, got)
+	})
+
+	t.Run("json empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeFindings(NewJSONWriter(&buf), nil))
+		assert.Equal(t, "[]\n", buf.String())
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeFindings(NewCSVWriter(&buf), findings))
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		assert.Len(t, lines, 3)
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeFindings(NewSarifWriter(&buf, config.Config{}), findings))
+		var got Sarif
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Len(t, got.Runs, 1)
+		assert.Len(t, got.Runs[0].Results, 2)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeFindings(NewJunitWriter(&buf), findings))
+		assert.Contains(t, buf.String(), `tests="2"`)
+	})
+}
@@ -1,9 +1,11 @@
 package sources
 
 import (
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/fatih/semgroup"
 	"github.com/rs/zerolog/log"
@@ -14,11 +16,27 @@ type ScanTarget struct {
 	Symlink string
 }
 
+// DirectoryTargets walks source and returns the regular files in it as scan
+// targets. Hidden files and directories such as .env or .aws are scanned,
+// since that is where secrets are often kept, but .git directories are not.
+// With followSymlinks, symlinks to files inside source are scanned too.
+// Symlinks that are broken, form a cycle, point to a directory, point into a
+// .git directory or point outside of source are skipped, so the walk always
+// terminates and never reads files the caller did not ask for.
 func DirectoryTargets(source string, s *semgroup.Group, followSymlinks bool) (<-chan ScanTarget, error) {
+	root, err := filepath.EvalSymlinks(source)
+	if err != nil {
+		return nil, err
+	}
+	if root, err = filepath.Abs(root); err != nil {
+		return nil, err
+	}
 	paths := make(chan ScanTarget)
-	s.Go(func() error {
+	// the walk blocks until each path is received, so it must not hold one of
+	// the slots of s that the scan of the paths needs
+	go func() {
 		defer close(paths)
-		return filepath.Walk(source,
+		err := filepath.Walk(source,
 			func(path string, fInfo os.FileInfo, err error) error {
 				if err != nil {
 					return err
@@ -37,10 +55,26 @@ func DirectoryTargets(source string, s *semgroup.Group, followSymlinks bool) (<-
 				}
 				if fInfo.Mode().Type() == fs.ModeSymlink && followSymlinks {
 					realPath, err := filepath.EvalSymlinks(path)
+					if err != nil {
+						log.Debug().Err(err).Msgf("found broken or cyclic symlink: %s [skipping]", path)
+						return nil
+					}
+					absPath, err := filepath.Abs(realPath)
+					if err != nil {
+						return err
+					}
+					if !withinRoot(root, absPath) {
+						log.Debug().Msgf("found symlink outside of the scan root: %s -> %s [skipping]", path, realPath)
+						return nil
+					}
+					if inGitDir(root, absPath) {
+						log.Debug().Msgf("found symlink into a .git directory: %s -> %s [skipping]", path, realPath)
+						return nil
+					}
+					realPathFileInfo, err := os.Stat(realPath)
 					if err != nil {
 						return err
 					}
-					realPathFileInfo, _ := os.Stat(realPath)
 					if realPathFileInfo.IsDir() {
 						log.Debug().Msgf("found symlinked directory: %s -> %s [skipping]", path, realPath)
 						return nil
@@ -52,6 +86,67 @@ func DirectoryTargets(source string, s *semgroup.Group, followSymlinks bool) (<-
 				}
 				return nil
 			})
-	})
+		if err != nil {
+			// reported while the paths are still being received, so
+			// s.Wait includes it
+			s.Go(func() error { return err })
+		}
+	}()
+	return paths, nil
+}
+
+// withinRoot reports whether path is root or inside of it. Both paths must be
+// absolute and clean.
+func withinRoot(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// inGitDir reports whether path, which must be within root, is inside of a
+// .git directory. Git directories hold compressed objects and pack data that
+// are scanned through the history instead.
+func inGitDir(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		if part == ".git" {
+			return true
+		}
+	}
+	return false
+}
+
+// FileTargets returns the given files as scan targets. Unlike
+// DirectoryTargets it does not walk directories, so exactly the listed
+// files are scanned. Empty files are skipped.
+func FileTargets(files []string, s *semgroup.Group) (<-chan ScanTarget, error) {
+	var targets []ScanTarget
+	for _, file := range files {
+		fInfo, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		if fInfo.IsDir() {
+			return nil, fmt.Errorf("%s is a directory", file)
+		}
+		if fInfo.Size() == 0 {
+			continue
+		}
+		targets = append(targets, ScanTarget{Path: file})
+	}
+
+	paths := make(chan ScanTarget)
+	// like the walk of DirectoryTargets, sending must not hold a slot of s
+	go func() {
+		defer close(paths)
+		for _, target := range targets {
+			paths <- target
+		}
+	}()
 	return paths, nil
 }
@@ -0,0 +1,96 @@
+package sources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/fatih/semgroup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func directoryTargets(t *testing.T, source string) []ScanTarget {
+	t.Helper()
+	s := semgroup.NewGroup(context.Background(), 4)
+	paths, err := DirectoryTargets(source, s, true)
+	require.NoError(t, err)
+	var targets []ScanTarget
+	for p := range paths {
+		targets = append(targets, p)
+	}
+	require.NoError(t, s.Wait())
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Path != targets[j].Path {
+			return targets[i].Path < targets[j].Path
+		}
+		return targets[i].Symlink < targets[j].Symlink
+	})
+	return targets
+}
+
+func TestDirectoryTargetsSymlinks(t *testing.T) {
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "secret.txt")
+	require.NoError(t, os.WriteFile(secret, []byte("password = hunter2"), 0600))
+
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	file := filepath.Join(root, "config.env")
+	require.NoError(t, os.WriteFile(file, []byte("token = abc"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(root, "dir"), 0700))
+
+	// a symlink to a file inside the scan root is scanned
+	require.NoError(t, os.Symlink(file, filepath.Join(root, "link.env")))
+	// a symlink to itself
+	require.NoError(t, os.Symlink(filepath.Join(root, "self"), filepath.Join(root, "self")))
+	// a directory cycle
+	require.NoError(t, os.Symlink(root, filepath.Join(root, "dir", "loop")))
+	// a symlink outside of the scan root
+	require.NoError(t, os.Symlink(secret, filepath.Join(root, "escape.txt")))
+	// a broken symlink
+	require.NoError(t, os.Symlink(filepath.Join(root, "missing"), filepath.Join(root, "broken")))
+
+	assert.Equal(t, []ScanTarget{
+		{Path: file},
+		{Path: file, Symlink: filepath.Join(root, "link.env")},
+	}, directoryTargets(t, root))
+}
+
+func TestDirectoryTargetsHidden(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	files := map[string]string{
+		".env":                         "DB_PASSWORD=hunter2",
+		".npmrc":                       "//registry.npmjs.org/:_authToken=abc",
+		".aws/credentials":             "aws_secret_access_key=abc",
+		".git/config":                  "[core]",
+		".git/objects/ab/cdef0123":     "compressed",
+		"vendor/lib/.git/objects/pack": "pack data",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	// symlinks into .git are not followed either
+	require.NoError(t, os.Symlink(filepath.Join(root, ".git", "config"), filepath.Join(root, "gitconfig")))
+
+	assert.Equal(t, []ScanTarget{
+		{Path: filepath.Join(root, ".aws", "credentials")},
+		{Path: filepath.Join(root, ".env")},
+		{Path: filepath.Join(root, ".npmrc")},
+	}, directoryTargets(t, root))
+}
+
+func TestWithinRoot(t *testing.T) {
+	root := filepath.FromSlash("/repo")
+	assert.True(t, withinRoot(root, root))
+	assert.True(t, withinRoot(root, filepath.FromSlash("/repo/a/b")))
+	assert.True(t, withinRoot(root, filepath.FromSlash("/repo/..a")))
+	assert.False(t, withinRoot(root, filepath.FromSlash("/repository")))
+	assert.False(t, withinRoot(root, filepath.FromSlash("/etc/passwd")))
+	assert.False(t, withinRoot(root, filepath.FromSlash("/")))
+}
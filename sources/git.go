@@ -3,6 +3,7 @@ package sources
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"io"
 	"os/exec"
 	"path/filepath"
@@ -15,9 +16,13 @@ import (
 
 var quotedOptPattern = regexp.MustCompile(`^(?:"[^"]+"|'[^']+')$`)
 
+// defaultLogOpts are the `git log` options used when no logOpts are given.
+const defaultLogOpts = "--full-history --all"
+
 // GitCmd helps to work with Git's output.
 type GitCmd struct {
 	cmd         *exec.Cmd
+	repoPath    string
 	diffFilesCh <-chan *gitdiff.File
 	errCh       <-chan error
 }
@@ -25,11 +30,14 @@ type GitCmd struct {
 // NewGitLogCmd returns `*DiffFilesCmd` with two channels: `<-chan *gitdiff.File` and `<-chan error`.
 // Caller should read everything from channels until receiving a signal about their closure and call
 // the `func (*DiffFilesCmd) Wait()` error in order to release resources.
-func NewGitLogCmd(source string, logOpts string) (*GitCmd, error) {
+// extraArgs are added to the `git log` arguments, e.g. CommitMessageArgs.
+func NewGitLogCmd(source string, logOpts string, extraArgs ...string) (*GitCmd, error) {
 	sourceClean := filepath.Clean(source)
 	var cmd *exec.Cmd
+	// fuller includes the committer, which differs from the author for
+	// rebased or cherry-picked commits
 	if logOpts != "" {
-		args := []string{"-C", sourceClean, "log", "-p", "-U0"}
+		args := []string{"-C", sourceClean, "log", "-p", "-U0", "--pretty=fuller"}
 
 		// Ensure that the user-provided |logOpts| aren't wrapped in quotes.
 		// https://github.com/gitleaks/gitleaks/issues/1153
@@ -44,40 +52,146 @@ func NewGitLogCmd(source string, logOpts string) (*GitCmd, error) {
 			log.Warn().Msgf("the following `--log-opts` values may not work as expected: %v\n\tsee https://github.com/gitleaks/gitleaks/issues/1153 for more information", quotedOpts)
 		}
 
-		args = append(args, userArgs...)
+		args = append(args, withLogArgs(userArgs, extraArgs...)...)
 		cmd = exec.Command("git", args...)
 	} else {
-		cmd = exec.Command("git", "-C", sourceClean, "log", "-p", "-U0",
-			"--full-history", "--all")
+		args := append([]string{"-C", sourceClean, "log", "-p", "-U0", "--pretty=fuller"},
+			strings.Split(defaultLogOpts, " ")...)
+		cmd = exec.Command("git", withLogArgs(args, extraArgs...)...)
 	}
 
-	log.Debug().Msgf("executing: %s", cmd.String())
+	return newGitCmd(cmd, sourceClean)
+}
 
-	stdout, err := cmd.StdoutPipe()
+// NewGitCommitsCmd returns a `*GitCmd` for exactly the given commits, each
+// diffed against its parent, like `git show`. Commits may be abbreviated
+// hashes or any other name git resolves to a commit. An error is returned
+// for names that are not a commit of the repository.
+func NewGitCommitsCmd(source string, commits []string, extraArgs ...string) (*GitCmd, error) {
+	sourceClean := filepath.Clean(source)
+	args := []string{"-C", sourceClean, "log", "-p", "-U0", "--pretty=fuller", "--no-walk=unsorted"}
+	for _, commit := range commits {
+		sha, err := resolveCommit(sourceClean, commit)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, sha)
+	}
+	return newGitCmd(exec.Command("git", withLogArgs(args, extraArgs...)...), sourceClean)
+}
+
+// NewGitLatestCommitCmd returns a `*GitCmd` for the lines added by the
+// commit at HEAD. A merge commit is diffed against its first parent, so only
+// the changes merged into the current branch are scanned, and the initial
+// commit is diffed against the empty tree, which scans every file.
+func NewGitLatestCommitCmd(source string, extraArgs ...string) (*GitCmd, error) {
+	sourceClean := filepath.Clean(source)
+	sha, err := resolveCommit(sourceClean, "HEAD")
 	if err != nil {
 		return nil, err
 	}
-	stderr, err := cmd.StderrPipe()
+	// --no-walk instead of --max-count=1, so a --grep in extraArgs does not
+	// walk back to an older commit
+	cmd := exec.Command("git", withLogArgs([]string{"-C", sourceClean, "log", "-p", "-U0", "--pretty=fuller",
+		"-m", "--first-parent", "--no-walk", sha}, extraArgs...)...)
+	return newGitCmd(cmd, sourceClean)
+}
+
+// resolveCommit returns the full hash of the commit with the given name.
+func resolveCommit(source string, commit string) (string, error) {
+	// a leading - would be read as an option
+	if commit == "" || strings.HasPrefix(commit, "-") {
+		return "", fmt.Errorf("invalid commit %q", commit)
+	}
+	out, err := exec.Command("git", "-C", source, "rev-parse", "--verify", "--quiet", commit+"^{commit}").Output()
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("unknown commit %s", commit)
 	}
-	if err := cmd.Start(); err != nil {
-		return nil, err
+	return strings.TrimSpace(string(out)), nil
+}
+
+// withLogArgs adds --no-abbrev-commit and extraArgs to the `git log`
+// arguments. --no-abbrev-commit makes findings always have the full commit
+// hash even if the user passed --abbrev-commit or set log.abbrevCommit in
+// their git config, so every `git log` gitleaks runs must use it. The
+// arguments are added before a `--` separator, after which the arguments
+// are paths.
+func withLogArgs(args []string, extraArgs ...string) []string {
+	extraArgs = append([]string{"--no-abbrev-commit"}, extraArgs...)
+	for i, arg := range args {
+		if arg == "--" {
+			return append(append(append([]string{}, args[:i]...), extraArgs...), args[i:]...)
+		}
 	}
+	return append(args, extraArgs...)
+}
 
-	errCh := make(chan error)
-	go listenForStdErr(stderr, errCh)
+// CommitMessageArgs returns the `git log` arguments that limit the log to
+// commits with a message line matching include, or to commits without a
+// message line matching exclude, so git does not produce the patches of
+// the other commits. The patterns are Perl-compatible regexes. git inverts
+// all patterns at once, so when both are set only include is returned and
+// the caller must filter out exclude.
+func CommitMessageArgs(include string, exclude string) []string {
+	switch {
+	case include != "":
+		return []string{"--perl-regexp", "--grep=" + include}
+	case exclude != "":
+		return []string{"--perl-regexp", "--invert-grep", "--grep=" + exclude}
+	}
+	return nil
+}
 
-	gitdiffFiles, err := gitdiff.Parse(stdout)
+// LimitLogOpts returns logOpts limited to the maxCommits most recent
+// commits. `git log` lists commits most recent first, so the same commits
+// are scanned on every run.
+func LimitLogOpts(logOpts string, maxCommits int) string {
+	if logOpts == "" {
+		logOpts = defaultLogOpts
+	}
+	return fmt.Sprintf("%s --max-count=%d", logOpts, maxCommits)
+}
+
+// SubmoduleLogOpts returns logOpts with the changes inside of submodules
+// included in the log, as if their files were part of the repository.
+// Commits that update a submodule then list the files changed in the
+// submodule under its path. Only initialized submodules can be shown.
+func SubmoduleLogOpts(logOpts string) string {
+	if logOpts == "" {
+		logOpts = defaultLogOpts
+	}
+	return logOpts + " --submodule=diff"
+}
+
+// IsBareRepo reports whether source is a bare repository, such as a
+// clone made with `git clone --bare` or `--mirror`. Bare repositories have
+// no working tree, but their history is scanned like any other repository
+// since git log reads the objects directly.
+func IsBareRepo(source string) bool {
+	out, err := exec.Command("git", "-C", filepath.Clean(source), "rev-parse", "--is-bare-repository").Output()
+	return err == nil && strings.TrimSpace(string(out)) == "true"
+}
+
+// UninitializedSubmodules returns the paths of the submodules of the
+// repository at source that are not initialized. Their contents are not
+// scanned with SubmoduleLogOpts.
+func UninitializedSubmodules(source string) ([]string, error) {
+	out, err := exec.Command("git", "-C", filepath.Clean(source), "submodule", "status", "--recursive").Output()
 	if err != nil {
 		return nil, err
 	}
-
-	return &GitCmd{
-		cmd:         cmd,
-		diffFilesCh: gitdiffFiles,
-		errCh:       errCh,
-	}, nil
+	var paths []string
+	for _, line := range strings.Split(string(out), "\n") {
+		// uninitialized submodules are prefixed with -, e.g.
+		// -3f0a9c2e1b4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f vendor/lib
+		if !strings.HasPrefix(line, "-") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) >= 2 {
+			paths = append(paths, fields[1])
+		}
+	}
+	return paths, nil
 }
 
 // NewGitDiffCmd returns `*DiffFilesCmd` with two channels: `<-chan *gitdiff.File` and `<-chan error`.
@@ -91,6 +205,22 @@ func NewGitDiffCmd(source string, staged bool) (*GitCmd, error) {
 		cmd = exec.Command("git", "-C", sourceClean, "diff", "-U0", "--no-ext-diff",
 			"--staged", ".")
 	}
+	return newGitCmd(cmd, sourceClean)
+}
+
+// NewGitDeltaCmd returns a `*GitCmd` for the changes introduced on head since
+// it diverged from base. Only lines added by those changes are scanned, so
+// secrets that already existed at base are not reported again. This matches
+// how a pull request is checked in CI.
+func NewGitDeltaCmd(source string, base string, head string) (*GitCmd, error) {
+	sourceClean := filepath.Clean(source)
+	cmd := exec.Command("git", "-C", sourceClean, "diff", "-U0", "--no-ext-diff",
+		base+"..."+head)
+	return newGitCmd(cmd, sourceClean)
+}
+
+// newGitCmd starts cmd and parses its stdout as a patch.
+func newGitCmd(cmd *exec.Cmd, sourceClean string) (*GitCmd, error) {
 	log.Debug().Msgf("executing: %s", cmd.String())
 
 	stdout, err := cmd.StdoutPipe()
@@ -115,11 +245,17 @@ func NewGitDiffCmd(source string, staged bool) (*GitCmd, error) {
 
 	return &GitCmd{
 		cmd:         cmd,
+		repoPath:    sourceClean,
 		diffFilesCh: gitdiffFiles,
 		errCh:       errCh,
 	}, nil
 }
 
+// RepoPath returns the path of the repository the command runs against.
+func (c *GitCmd) RepoPath() string {
+	return c.repoPath
+}
+
 // DiffFilesCh returns a channel with *gitdiff.File.
 func (c *GitCmd) DiffFilesCh() <-chan *gitdiff.File {
 	return c.diffFilesCh
@@ -138,6 +274,21 @@ func (c *GitCmd) Wait() (err error) {
 	return c.cmd.Wait()
 }
 
+// Kill stops git before it has written the whole log. The channels are
+// closed once the output written so far has been read, Wait must still be
+// called.
+func (c *GitCmd) Kill() error {
+	return c.cmd.Process.Kill()
+}
+
+// ShowFile returns the content of the file at path in revision rev of the
+// repository at source.
+func ShowFile(source string, rev string, path string) ([]byte, error) {
+	cmd := exec.Command("git", "-C", filepath.Clean(source), "show", rev+":"+path)
+	log.Debug().Msgf("executing: %s", cmd.String())
+	return cmd.Output()
+}
+
 // listenForStdErr listens for stderr output from git, prints it to stdout,
 // sends to errCh and closes it.
 func listenForStdErr(stderr io.ReadCloser, errCh chan<- error) {
@@ -1,5 +1,11 @@
 package sources
 
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
 // TODO: commenting out this test for now because it's flaky. Alternatives to consider to get this working:
 // -- use `git stash` instead of `restore()`
 
@@ -156,3 +162,9 @@ package sources
 // 	}
 // 	return nil
 // }
+
+func TestWithLogArgs(t *testing.T) {
+	assert.Equal(t, []string{"--all", "--no-abbrev-commit"}, withLogArgs([]string{"--all"}))
+	assert.Equal(t, []string{"--abbrev-commit", "--no-abbrev-commit", "--", "src"}, withLogArgs([]string{"--abbrev-commit", "--", "src"}))
+	assert.Equal(t, []string{"--all", "--no-abbrev-commit", "--grep=x", "--", "src"}, withLogArgs([]string{"--all", "--", "src"}, "--grep=x"))
+}
@@ -0,0 +1,48 @@
+package verify
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+const githubAPI = "https://api.github.com"
+
+// GitHubToken verifies GitHub personal access tokens by requesting the
+// authenticated user.
+type GitHubToken struct {
+	Client *http.Client
+
+	// BaseURL is the GitHub API url, defaults to https://api.github.com
+	BaseURL string
+}
+
+// Verify implements Verifier.
+func (g *GitHubToken) Verify(finding report.Finding) (bool, error) {
+	baseURL := g.BaseURL
+	if baseURL == "" {
+		baseURL = githubAPI
+	}
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/user", nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "token "+finding.Secret)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusUnauthorized:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status from %s: %s", req.URL, resp.Status)
+	}
+}
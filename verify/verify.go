@@ -0,0 +1,76 @@
+package verify
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+// DefaultWorkers is the number of verifications the default registry runs
+// at the same time.
+const DefaultWorkers = 4
+
+// Verifier checks whether the secret of a finding is a live credential.
+// Verifiers make network calls, so they are only used when verification is
+// explicitly enabled.
+type Verifier interface {
+	Verify(finding report.Finding) (active bool, err error)
+}
+
+// Registry dispatches findings to the Verifier registered for their rule
+// and bounds how many verifications run concurrently. A Registry is itself
+// a Verifier.
+type Registry struct {
+	mu        sync.RWMutex
+	verifiers map[string]Verifier
+	workers   chan struct{}
+}
+
+// NewRegistry returns an empty registry that runs at most workers
+// verifications at the same time.
+func NewRegistry(workers int) *Registry {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Registry{
+		verifiers: make(map[string]Verifier),
+		workers:   make(chan struct{}, workers),
+	}
+}
+
+// Default returns a registry with the verifiers shipped with gitleaks.
+func Default(client *http.Client) *Registry {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	r := NewRegistry(DefaultWorkers)
+	github := &GitHubToken{Client: client}
+	r.Register("github-pat", github)
+	r.Register("github-fine-grained-pat", github)
+	return r
+}
+
+// Register sets the verifier for findings of ruleID, replacing any
+// verifier registered before.
+func (r *Registry) Register(ruleID string, v Verifier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.verifiers[ruleID] = v
+}
+
+// Verify verifies a finding with the verifier registered for its rule.
+// Findings of rules without a verifier are reported as not active.
+func (r *Registry) Verify(finding report.Finding) (bool, error) {
+	r.mu.RLock()
+	v, ok := r.verifiers[finding.RuleID]
+	r.mu.RUnlock()
+	if !ok {
+		return false, nil
+	}
+
+	r.workers <- struct{}{}
+	defer func() { <-r.workers }()
+	return v.Verify(finding)
+}
@@ -0,0 +1,86 @@
+package verify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zricethezav/gitleaks/v8/report"
+)
+
+func TestGitHubToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user", r.URL.Path)
+		switch r.Header.Get("Authorization") {
+		case "token ghp_live":
+			w.WriteHeader(http.StatusOK)
+		case "token ghp_revoked":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	verifier := &GitHubToken{Client: server.Client(), BaseURL: server.URL}
+
+	active, err := verifier.Verify(report.Finding{Secret: "ghp_live"})
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = verifier.Verify(report.Finding{Secret: "ghp_revoked"})
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	active, err = verifier.Verify(report.Finding{Secret: "ghp_ratelimited"})
+	require.Error(t, err)
+	assert.False(t, active)
+}
+
+type verifierFunc func(report.Finding) (bool, error)
+
+func (f verifierFunc) Verify(finding report.Finding) (bool, error) {
+	return f(finding)
+}
+
+func TestRegistry(t *testing.T) {
+	var running, maxRunning int32
+	slow := verifierFunc(func(report.Finding) (bool, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return true, nil
+	})
+
+	registry := NewRegistry(2)
+	registry.Register("github-pat", slow)
+
+	active, err := registry.Verify(report.Finding{RuleID: "aws-access-token"})
+	require.NoError(t, err)
+	assert.False(t, active, "rules without a verifier are not active")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			active, err := registry.Verify(report.Finding{RuleID: "github-pat"})
+			assert.NoError(t, err)
+			assert.True(t, active)
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}